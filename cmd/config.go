@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Arcanm/deliveryPlannerGolang/config"
+)
+
+// runConfig prints the effective configuration with "config print"
+func runConfig(cfg *config.Config, args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return fmt.Errorf("unknown config command %q, want \"print\"", strings.Join(args, " "))
+	}
+	return cfg.Print(os.Stdout)
+}
@@ -2,128 +2,235 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	grpcserver "google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/Arcanm/deliveryPlannerGolang/config"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/health"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/lifecycle"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/memory"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/mongodb"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/storage/filesystem"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/tracing"
 	grpcimpl "github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/grpc"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/http/handlers"
 	"github.com/Arcanm/deliveryPlannerGolang/proto"
 )
 
 func main() {
-	// Initialize MongoDB connection
-	mongoClient, err := mongodb.NewClient()
+	// Layer the defaults, the config file, the environment and the flags, and stop if they are invalid
+	cfg, args, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fatal("invalid configuration", err)
+	}
+
+	// Log JSON records at the configured level, tagged with the request ID when there is one
+	logger, err := logging.New(os.Stdout, cfg.LogLevel)
 	if err != nil {
-		log.Fatal("Failed to connect to MongoDB:", err)
+		fatal("invalid logging configuration", err)
+	}
+	slog.SetDefault(logger)
+
+	// The migrate and config subcommands run instead of the servers
+	if len(args) > 0 {
+		switch args[0] {
+		case "migrate":
+			if err := runMigrate(cfg, args[1:]); err != nil {
+				fatal("migration failed", err)
+			}
+		case "config":
+			if err := runConfig(cfg, args[1:]); err != nil {
+				fatal("config command failed", err)
+			}
+		default:
+			fatal("invalid command", fmt.Errorf("unknown command %q, want migrate or config", args[0]))
+		}
+		return
 	}
-	defer mongoClient.Disconnect(context.Background())
 
-	// Get database name from environment variable or use default
-	dbName := "delivery_planner"
-	if name := os.Getenv("MONGODB_DB"); name != "" {
-		dbName = name
+	// Export spans and continue the trace context of incoming requests
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		File:        cfg.Tracing.File,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		Environment: cfg.Environment,
+	})
+	if err != nil {
+		fatal("invalid tracing configuration", err)
 	}
-	db := mongoClient.Database(dbName)
+
+	// The lifecycle manager runs the servers and workers, then releases the resources in the order
+	// they are registered here, which closes the MongoDB client last
+	app := lifecycle.New(cfg.DrainDelay, cfg.ShutdownTimeout)
+	app.OnClose("tracing", shutdownTracing)
+
+	// Readiness checks, served on /readyz and by the gRPC health service, and turned off as soon
+	// as the shutdown starts
+	grpcHealth := grpchealth.NewServer()
+	checker := health.NewChecker(grpcHealth, cfg.Health.CheckInterval)
+	app.AddWorker("health", checker.Run)
 
 	// Initialize repositories
-	driverRepo := repositories.NewDriverRepository(db)
-	packageRepo := repositories.NewPackageRepository(db)
-	routeRepo := repositories.NewRouteRepository(db)
+	var (
+		driverRepo       repositories.DriverRepository
+		packageRepo      repositories.PackageRepository
+		routeRepo        repositories.RouteRepository
+		planRepo         repositories.PlanRepository
+		packageEventRepo repositories.PackageEventRepository
+		unitOfWork       repositories.UnitOfWork
+	)
+	switch cfg.StorageBackend {
+	case config.StorageBackendMemory:
+		slog.Warn("using in-memory storage; data will be lost on shutdown")
+		driverRepo = memory.NewDriverRepository()
+		packageRepo = memory.NewPackageRepository()
+		routeRepo = memory.NewRouteRepository()
+		planRepo = memory.NewPlanRepository()
+		packageEventRepo = memory.NewPackageEventRepository()
+		unitOfWork = memory.NewUnitOfWork()
+	case config.StorageBackendMongoDB:
+		// Initialize MongoDB connection
+		mongoClient, db, err := connectDatabase(cfg.Mongo)
+		if err != nil {
+			fatal("failed to connect to MongoDB", err)
+		}
+		app.OnClose("mongodb", mongoClient.Disconnect)
+
+		// Bring indexes and stored data up to date before serving
+		migrator := mongodb.NewMigrator(db, mongodb.Migrations)
+		if cfg.Features.AutoMigrate {
+			if err := migrator.Up(context.Background()); err != nil {
+				fatal("failed to apply migrations", err)
+			}
+		}
+
+		checker.AddCheck("mongodb", func(ctx context.Context) error {
+			return mongoClient.Ping(ctx, readpref.Primary())
+		})
+		checker.AddCheck("migrations", migrator.CheckApplied)
+
+		driverRepo = mongodb.NewDriverRepository(db)
+		packageRepo = mongodb.NewPackageRepository(db)
+		routeRepo = mongodb.NewRouteRepository(db)
+		planRepo = mongodb.NewPlanRepository(db)
+		packageEventRepo = mongodb.NewPackageEventRepository(db)
+		unitOfWork = mongodb.NewUnitOfWork(mongoClient)
+	default:
+		fatal("invalid storage configuration", fmt.Errorf("unknown storage backend %q", cfg.StorageBackend))
+	}
+
+	// Initialize blob storage for proof-of-delivery attachments
+	blobStore, err := filesystem.NewBlobStore(cfg.BlobStorePath)
+	if err != nil {
+		fatal("failed to initialize blob store", err)
+	}
 
 	// Initialize services
 	driverService := services.NewDriverService(driverRepo, routeRepo)
-	packageService := services.NewPackageService(packageRepo)
-	routeService := services.NewRouteService(routeRepo, driverRepo, packageRepo)
+	packageService := services.NewPackageService(packageRepo, packageEventRepo, blobStore)
+	optimizer, err := optimization.NewOptimizer(cfg.Optimizer.Constructor, cfg.Optimizer.Improvers)
+	if err != nil {
+		fatal("invalid optimizer configuration", err)
+	}
+	routeService := services.NewRouteService(routeRepo, driverRepo, packageRepo, packageEventRepo, unitOfWork, blobStore, optimizer, cfg.VehicleCapacities, cfg.MaxDeliveryAttempts, cfg.ShiftStart)
+	planningService := services.NewPlanningService(planRepo, driverRepo, packageRepo, routeService, optimization.NewFleetPlanner(optimizer), cfg.VehicleCapacities)
 
 	// Initialize gRPC server
-	grpcServer := grpcserver.NewServer()
+	grpcServer := grpcserver.NewServer(
+		grpcserver.StatsHandler(otelgrpc.NewServerHandler()),
+		grpcserver.ChainUnaryInterceptor(grpcimpl.RequestIDUnaryInterceptor, grpcimpl.LoggingUnaryInterceptor, grpcimpl.MetricsUnaryInterceptor, grpcimpl.ActorUnaryInterceptor),
+		grpcserver.ChainStreamInterceptor(grpcimpl.RequestIDStreamInterceptor, grpcimpl.LoggingStreamInterceptor, grpcimpl.MetricsStreamInterceptor),
+	)
 
 	// Register gRPC services
 	proto.RegisterDriverServiceServer(grpcServer, grpcimpl.NewDriverService(driverService))
 	proto.RegisterPackageServiceServer(grpcServer, grpcimpl.NewPackageService(packageService, routeService))
 	proto.RegisterRouteServiceServer(grpcServer, grpcimpl.NewRouteService(routeService))
 
+	// Register the standard health service, for gRPC load balancers and probes
+	healthpb.RegisterHealthServer(grpcServer, grpcHealth)
+
 	// Register reflection service on gRPC server
-	reflection.Register(grpcServer)
+	if cfg.Features.GRPCReflection {
+		reflection.Register(grpcServer)
+	}
 
 	// Start gRPC server
-	grpcPort := os.Getenv("GRPC_PORT")
-	if grpcPort == "" {
-		grpcPort = "50051"
-	}
-	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
 	if err != nil {
-		log.Fatal("Failed to listen for gRPC:", err)
+		fatal("failed to listen for gRPC", err)
 	}
 
 	// Initialize HTTP server
-	router := gin.Default()
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	router.Use(handlers.TracingMiddleware(tracing.ServiceName), handlers.RequestIDMiddleware(), handlers.LoggerMiddleware(), handlers.MetricsMiddleware(), handlers.RecoveryMiddleware(), handlers.ActorMiddleware())
 
 	// Initialize HTTP handlers
 	driverHandler := handlers.NewDriverHandler(driverService)
 	packageHandler := handlers.NewPackageHandler(packageService, routeService)
 	routeHandler := handlers.NewRouteHandler(routeService)
+	planHandler := handlers.NewPlanHandler(planningService)
 
 	// Register HTTP routes
 	driverHandler.RegisterRoutes(router)
 	packageHandler.RegisterRoutes(router)
 	routeHandler.RegisterRoutes(router)
+	planHandler.RegisterRoutes(router)
 
-	// Health check route
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status": "ok",
-		})
-	})
+	// Liveness and readiness probes
+	handlers.NewHealthHandler(checker).RegisterRoutes(router)
 
-	// Setup HTTP port
-	httpPort := os.Getenv("HTTP_PORT")
-	if httpPort == "" {
-		httpPort = "8080"
+	// Prometheus scrape endpoint, with the package and route gauges kept up to date until shutdown
+	if cfg.Features.Metrics {
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+		app.AddWorker("kpi-refresher", metrics.NewKPIRefresher(packageRepo, routeRepo, cfg.Metrics.RefreshInterval).Run)
+		checker.AddCheck("kpi-refresher", app.CheckWorker("kpi-refresher"))
 	}
 
-	// Create channels for graceful shutdown
-	grpcErr := make(chan error, 1)
-	httpErr := make(chan error, 1)
-
-	// Start gRPC server in a goroutine
-	go func() {
-		log.Printf("Starting gRPC server on port %s", grpcPort)
-		if err := grpcServer.Serve(grpcListener); err != nil {
-			grpcErr <- err
-		}
-	}()
-
-	// Start HTTP server in a goroutine
-	go func() {
-		log.Printf("Starting HTTP server on port %s", httpPort)
-		if err := router.Run(":" + httpPort); err != nil {
-			httpErr <- err
-		}
-	}()
-
-	// Wait for interrupt signal
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-
-	// Wait for either server error or interrupt signal
-	select {
-	case err := <-grpcErr:
-		log.Printf("gRPC server error: %v", err)
-	case err := <-httpErr:
-		log.Printf("HTTP server error: %v", err)
-	case <-quit:
-		log.Println("Shutting down servers...")
-		grpcServer.GracefulStop()
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.HTTP.Port),
+		Handler:           router,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	app.AddHTTPServer("http", httpServer)
+	app.AddGRPCServer("grpc", grpcServer, grpcListener)
+
+	// Serve until SIGINT or SIGTERM, or until a server fails, then drain within the shutdown timeout
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	slog.Info("starting servers", slog.Int("http_port", cfg.HTTP.Port), slog.Int("grpc_port", cfg.GRPC.Port))
+	if err := app.Run(ctx); err != nil {
+		fatal("server failed", err)
 	}
 }
+
+// fatal logs a failure that prevents the server from running and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	os.Exit(1)
+}
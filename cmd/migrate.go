@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/config"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/mongodb"
+)
+
+// connectDatabase connects to MongoDB and selects the application database
+func connectDatabase(cfg config.MongoConfig) (*mongo.Client, *mongo.Database, error) {
+	client, err := mongodb.NewClient(mongodb.ClientConfig{
+		URI:                    cfg.URI,
+		MinPoolSize:            cfg.MinPoolSize,
+		MaxPoolSize:            cfg.MaxPoolSize,
+		ConnectTimeout:         cfg.ConnectTimeout,
+		ServerSelectionTimeout: cfg.ServerSelectionTimeout,
+		Timeout:                cfg.Timeout,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, client.Database(cfg.Database), nil
+}
+
+// runMigrate applies the pending migrations, or lists every migration with "migrate status"
+func runMigrate(cfg *config.Config, args []string) error {
+	ctx := context.Background()
+
+	client, db, err := connectDatabase(cfg.Mongo)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(ctx)
+
+	migrator := mongodb.NewMigrator(db, mongodb.Migrations)
+	if len(args) == 0 {
+		return migrator.Up(ctx)
+	}
+	if args[0] != "status" {
+		return fmt.Errorf("unknown migrate command %q, want no argument or \"status\"", args[0])
+	}
+
+	applied, err := migrator.Applied(ctx)
+	if err != nil {
+		return err
+	}
+	for _, migration := range mongodb.Migrations {
+		state := "pending"
+		if record, ok := applied[migration.Version]; ok {
+			state = "applied " + record.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%3d  %-28s %s\n", migration.Version, state, migration.Description)
+	}
+	return nil
+}
@@ -1,36 +1,279 @@
 package config
 
 import (
-	"os"
-	"strconv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/tracing"
+)
+
+// Storage backends selectable with STORAGE_BACKEND
+const (
+	StorageBackendMongoDB = "mongodb"
+	StorageBackendMemory  = "memory"
 )
 
+// Config is the effective configuration of the server, layered from the built-in defaults, an
+// optional YAML file, the environment and the command line flags, each overriding the previous one
 type Config struct {
-	MongoURI     string
-	DatabaseName string
-	HTTPPort     int
-	GRPCPort     int
-	Environment  string
-	LogLevel     string
+	Environment string `yaml:"environment"`
+
+	// StorageBackend selects where data is kept: "mongodb" (default) or "memory" for tests and demos
+	StorageBackend string `yaml:"storage_backend"`
+
+	// LogLevel is the lowest level of the JSON logs: debug, info (default), warn or error
+	LogLevel string `yaml:"log_level"`
+
+	HTTP  ServerConfig `yaml:"http"`
+	GRPC  ServerConfig `yaml:"grpc"`
+	Mongo MongoConfig  `yaml:"mongodb"`
+
+	Optimizer OptimizerConfig `yaml:"optimizer"`
+
+	// VehicleCapacities holds the load limits per vehicle type; each type lists both limits
+	VehicleCapacities models.VehicleCapacities `yaml:"vehicle_capacities"`
+
+	// MaxDeliveryAttempts is the number of failed attempts after which a package is returned to the sender
+	MaxDeliveryAttempts int `yaml:"max_delivery_attempts"`
+
+	// ShiftStart is the time of day, as an offset from midnight, when drivers set off on routes
+	// dated without a time of day; the stop arrivals are scheduled from it
+	ShiftStart time.Duration `yaml:"shift_start"`
+
+	// BlobStorePath is the directory where proof-of-delivery signatures and photos are kept
+	BlobStorePath string `yaml:"blob_store_path"`
+
+	// DrainDelay is how long the servers keep serving once the shutdown starts, reporting not ready,
+	// so that load balancers stop routing to them before the listeners close
+	DrainDelay time.Duration `yaml:"drain_delay"`
+
+	// ShutdownTimeout bounds how long the servers get to drain in-flight requests on shutdown
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	Health   HealthConfig   `yaml:"health"`
+	Metrics  MetricsConfig  `yaml:"metrics"`
+	Tracing  TracingConfig  `yaml:"tracing"`
+	Features FeaturesConfig `yaml:"features"`
 }
 
-func LoadConfig() *Config {
-	httpPort, _ := strconv.Atoi(getEnvOrDefault("HTTP_PORT", "8080"))
-	grpcPort, _ := strconv.Atoi(getEnvOrDefault("GRPC_PORT", "9090"))
+// ServerConfig configures a listening server
+type ServerConfig struct {
+	Port int `yaml:"port"`
+}
 
+// MongoConfig configures the MongoDB connection; zero pool sizes keep the driver defaults
+type MongoConfig struct {
+	URI                    string        `yaml:"uri"`
+	Database               string        `yaml:"database"`
+	MinPoolSize            uint64        `yaml:"min_pool_size"`
+	MaxPoolSize            uint64        `yaml:"max_pool_size"`
+	ConnectTimeout         time.Duration `yaml:"connect_timeout"`
+	ServerSelectionTimeout time.Duration `yaml:"server_selection_timeout"`
+	// Timeout bounds every operation that has no earlier deadline of its own
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// OptimizerConfig names the algorithms sequencing the stops of a route
+type OptimizerConfig struct {
+	// Constructor builds the initial tour; empty keeps the order the stops were added in
+	Constructor string `yaml:"constructor"`
+	// Improvers refine the tour, in order
+	Improvers []string `yaml:"improvers"`
+}
+
+// HealthConfig configures the readiness checks
+type HealthConfig struct {
+	// CheckInterval is how often the readiness published by the gRPC health service is recomputed
+	CheckInterval time.Duration `yaml:"check_interval"`
+}
+
+// MetricsConfig configures the Prometheus metrics
+type MetricsConfig struct {
+	// RefreshInterval is how often the package and route gauges are recomputed from storage
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// TracingConfig configures the OpenTelemetry span exporter
+type TracingConfig struct {
+	// Exporter sends spans to an OTLP collector ("otlp", configured with the standard
+	// OTEL_EXPORTER_OTLP_* variables), to stdout ("stdout") or to File ("file"); "none" disables tracing
+	Exporter    string  `yaml:"exporter"`
+	File        string  `yaml:"file"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// FeaturesConfig switches optional behaviour on and off
+type FeaturesConfig struct {
+	// AutoMigrate applies pending MongoDB migrations at startup; when disabled they are applied
+	// with the migrate subcommand
+	AutoMigrate bool `yaml:"auto_migrate"`
+	// Metrics serves /metrics and keeps the business gauges up to date
+	Metrics bool `yaml:"metrics"`
+	// GRPCReflection lets clients such as grpcurl discover the gRPC services
+	GRPCReflection bool `yaml:"grpc_reflection"`
+}
+
+// Default returns the configuration used for every setting no source overrides
+func Default() *Config {
 	return &Config{
-		MongoURI:     getEnvOrDefault("MONGO_URI", "mongodb://localhost:27017"),
-		DatabaseName: getEnvOrDefault("DB_NAME", "delivery_planner"),
-		HTTPPort:     httpPort,
-		GRPCPort:     grpcPort,
-		Environment:  getEnvOrDefault("ENV", "development"),
-		LogLevel:     getEnvOrDefault("LOG_LEVEL", "info"),
+		Environment:    "development",
+		StorageBackend: StorageBackendMongoDB,
+		LogLevel:       "info",
+
+		HTTP: ServerConfig{Port: 8080},
+		GRPC: ServerConfig{Port: 50051},
+		Mongo: MongoConfig{
+			URI:                    "mongodb://localhost:27017",
+			Database:               "delivery_planner",
+			MaxPoolSize:            100,
+			ConnectTimeout:         10 * time.Second,
+			ServerSelectionTimeout: 10 * time.Second,
+		},
+
+		Optimizer: OptimizerConfig{
+			Constructor: "nearest_neighbour",
+			Improvers:   []string{"two_opt", "or_opt"},
+		},
+		VehicleCapacities: defaultVehicleCapacities(),
+
+		MaxDeliveryAttempts: 3,
+		ShiftStart:          8 * time.Hour,
+		BlobStorePath:       "data/blobs",
+		DrainDelay:          5 * time.Second,
+		ShutdownTimeout:     30 * time.Second,
+
+		Health:  HealthConfig{CheckInterval: 10 * time.Second},
+		Metrics: MetricsConfig{RefreshInterval: 30 * time.Second},
+		Tracing: TracingConfig{
+			Exporter:    tracing.ExporterNone,
+			File:        "data/traces.json",
+			SampleRatio: 1,
+		},
+		Features: FeaturesConfig{
+			AutoMigrate:    true,
+			Metrics:        true,
+			GRPCReflection: true,
+		},
+	}
+}
+
+func defaultVehicleCapacities() models.VehicleCapacities {
+	capacities := make(models.VehicleCapacities, len(models.DefaultVehicleCapacities))
+	for vehicleType, capacity := range models.DefaultVehicleCapacities {
+		capacities[vehicleType] = capacity
 	}
+	return capacities
 }
 
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// Validate reports every invalid setting at once
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(setting, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", setting, fmt.Sprintf(format, args...)))
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+		invalid("log_level", "want debug, info, warn or error, got %q", c.LogLevel)
 	}
-	return defaultValue
+
+	for _, server := range []struct {
+		name string
+		port int
+	}{{"http.port", c.HTTP.Port}, {"grpc.port", c.GRPC.Port}} {
+		if server.port < 1 || server.port > 65535 {
+			invalid(server.name, "want a port between 1 and 65535, got %d", server.port)
+		}
+	}
+	if c.HTTP.Port == c.GRPC.Port {
+		invalid("grpc.port", "must differ from http.port %d", c.HTTP.Port)
+	}
+
+	switch c.StorageBackend {
+	case StorageBackendMemory:
+	case StorageBackendMongoDB:
+		if !strings.HasPrefix(c.Mongo.URI, "mongodb://") && !strings.HasPrefix(c.Mongo.URI, "mongodb+srv://") {
+			invalid("mongodb.uri", "want a mongodb:// or mongodb+srv:// connection string, got %q", redactURI(c.Mongo.URI))
+		}
+		if c.Mongo.Database == "" {
+			invalid("mongodb.database", "must not be empty")
+		}
+		if c.Mongo.MaxPoolSize > 0 && c.Mongo.MinPoolSize > c.Mongo.MaxPoolSize {
+			invalid("mongodb.min_pool_size", "%d exceeds max_pool_size %d", c.Mongo.MinPoolSize, c.Mongo.MaxPoolSize)
+		}
+		for _, timeout := range []struct {
+			name  string
+			value time.Duration
+		}{
+			{"mongodb.connect_timeout", c.Mongo.ConnectTimeout},
+			{"mongodb.server_selection_timeout", c.Mongo.ServerSelectionTimeout},
+			{"mongodb.timeout", c.Mongo.Timeout},
+		} {
+			if timeout.value < 0 {
+				invalid(timeout.name, "must not be negative, got %s", timeout.value)
+			}
+		}
+	default:
+		invalid("storage_backend", "want %q or %q, got %q", StorageBackendMongoDB, StorageBackendMemory, c.StorageBackend)
+	}
+
+	if _, err := optimization.NewOptimizer(c.Optimizer.Constructor, c.Optimizer.Improvers); err != nil {
+		invalid("optimizer", "%v", err)
+	}
+
+	vehicleTypes := make([]models.VehicleType, 0, len(c.VehicleCapacities))
+	for vehicleType := range c.VehicleCapacities {
+		vehicleTypes = append(vehicleTypes, vehicleType)
+	}
+	slices.Sort(vehicleTypes)
+	for _, vehicleType := range vehicleTypes {
+		if !vehicleType.IsValid() {
+			invalid("vehicle_capacities", "unknown vehicle type %q", vehicleType)
+		} else if err := c.VehicleCapacities[vehicleType].Validate(); err != nil {
+			invalid("vehicle_capacities."+string(vehicleType), "%v", err)
+		}
+	}
+
+	if c.MaxDeliveryAttempts < 1 {
+		invalid("max_delivery_attempts", "want at least 1, got %d", c.MaxDeliveryAttempts)
+	}
+	if c.ShiftStart < 0 || c.ShiftStart >= 24*time.Hour {
+		invalid("shift_start", "want a time of day between 0s and 24h, got %s", c.ShiftStart)
+	}
+	if c.BlobStorePath == "" {
+		invalid("blob_store_path", "must not be empty")
+	}
+	if c.DrainDelay < 0 {
+		invalid("drain_delay", "must not be negative, got %s", c.DrainDelay)
+	}
+	if c.ShutdownTimeout <= 0 {
+		invalid("shutdown_timeout", "must be positive, got %s", c.ShutdownTimeout)
+	}
+	if c.Health.CheckInterval <= 0 {
+		invalid("health.check_interval", "must be positive, got %s", c.Health.CheckInterval)
+	}
+	if c.Metrics.RefreshInterval <= 0 {
+		invalid("metrics.refresh_interval", "must be positive, got %s", c.Metrics.RefreshInterval)
+	}
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterStdout:
+	case tracing.ExporterFile:
+		if c.Tracing.File == "" {
+			invalid("tracing.file", "must not be empty with the file exporter")
+		}
+	default:
+		invalid("tracing.exporter", "want none, otlp, stdout or file, got %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		invalid("tracing.sample_ratio", "want a fraction between 0 and 1, got %v", c.Tracing.SampleRatio)
+	}
+
+	return errors.Join(errs...)
 }
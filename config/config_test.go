@@ -0,0 +1,208 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// writeFile writes a YAML config file in a temporary directory and returns its path
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	file := writeFile(t, `
+http:
+  port: 9000
+grpc:
+  port: 9001
+mongodb:
+  database: from_file
+  timeout: 5s
+optimizer:
+  improvers: [or_opt]
+vehicle_capacities:
+  van: {max_weight_kg: 900, max_volume_m3: 7}
+`)
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		args     []string
+		check    func(t *testing.T, cfg *Config)
+		wantArgs []string
+	}{
+		{
+			name: "defaults",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != 8080 || cfg.GRPC.Port != 50051 || cfg.Mongo.URI != "mongodb://localhost:27017" || cfg.Mongo.Database != "delivery_planner" {
+					t.Errorf("cfg = %+v, want the defaults", cfg)
+				}
+			},
+		},
+		{
+			name: "file overrides the defaults",
+			args: []string{"--config", file},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != 9000 || cfg.Mongo.Database != "from_file" || cfg.Mongo.Timeout != 5*time.Second {
+					t.Errorf("cfg = %+v, want the file settings", cfg)
+				}
+				if cfg.Mongo.URI != "mongodb://localhost:27017" {
+					t.Errorf("Mongo.URI = %q, want the default kept", cfg.Mongo.URI)
+				}
+				if van := cfg.VehicleCapacities[models.VehicleTypeVan]; van.MaxWeightKg != 900 {
+					t.Errorf("van capacity = %+v, want the file's", van)
+				}
+				if bike := cfg.VehicleCapacities[models.VehicleTypeBike]; bike != models.DefaultVehicleCapacities[models.VehicleTypeBike] {
+					t.Errorf("bike capacity = %+v, want the default kept", bike)
+				}
+			},
+		},
+		{
+			name: "environment overrides the file",
+			env:  map[string]string{"DELIVERY_CONFIG_FILE": file, "DELIVERY_HTTP_PORT": "9100", "DELIVERY_MONGODB_URI": "mongodb://db:27017", "DELIVERY_VAN_MAX_WEIGHT_KG": "950"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != 9100 || cfg.Mongo.URI != "mongodb://db:27017" || cfg.Mongo.Database != "from_file" {
+					t.Errorf("cfg = %+v, want the environment over the file", cfg)
+				}
+				if van := cfg.VehicleCapacities[models.VehicleTypeVan]; van.MaxWeightKg != 950 || van.MaxVolumeM3 != 7 {
+					t.Errorf("van capacity = %+v, want the environment's weight and the file's volume", van)
+				}
+			},
+		},
+		{
+			name: "empty environment variables clear the file",
+			env:  map[string]string{"DELIVERY_CONFIG_FILE": file, "DELIVERY_OPTIMIZER_CONSTRUCTOR": "", "DELIVERY_OPTIMIZER_IMPROVERS": ""},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Optimizer.Constructor != "" || len(cfg.Optimizer.Improvers) != 0 {
+					t.Errorf("Optimizer = %+v, want it cleared by the environment", cfg.Optimizer)
+				}
+				if cfg.Mongo.Database != "from_file" {
+					t.Errorf("Mongo.Database = %q, want the file's kept", cfg.Mongo.Database)
+				}
+			},
+		},
+		{
+			name: "unprefixed variables ignored",
+			env:  map[string]string{"ENV": "/etc/shrc", "HTTP_PORT": "9100", "DELIVERY_ENV": "staging"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Environment != "staging" || cfg.HTTP.Port != 8080 {
+					t.Errorf("cfg = %+v, want only the DELIVERY_ variables applied", cfg)
+				}
+			},
+		},
+		{
+			name: "flags override the environment",
+			env:  map[string]string{"DELIVERY_HTTP_PORT": "9100", "DELIVERY_OPTIMIZER_IMPROVERS": "two_opt"},
+			args: []string{"--config", file, "--http-port", "9200", "--optimizer-improvers", "or_opt,two_opt", "migrate", "status"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != 9200 || cfg.Mongo.Database != "from_file" {
+					t.Errorf("cfg = %+v, want the flags over the environment", cfg)
+				}
+				if got := strings.Join(cfg.Optimizer.Improvers, ","); got != "or_opt,two_opt" {
+					t.Errorf("Optimizer.Improvers = %s, want or_opt,two_opt", got)
+				}
+			},
+			wantArgs: []string{"migrate", "status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, args, err := Load(tt.args)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			tt.check(t, cfg)
+			if strings.Join(args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		args    []string
+		file    string
+		wantErr []string
+	}{
+		{name: "unparseable environment", env: map[string]string{"DELIVERY_GRPC_PORT": "grpc"}, wantErr: []string{"DELIVERY_GRPC_PORT"}},
+		{name: "unknown flag", args: []string{"--htp-port", "1"}, wantErr: []string{"htp-port"}},
+		{name: "unknown file setting", file: "htp:\n  port: 1\n", wantErr: []string{"field htp not found"}},
+		{name: "same port twice", args: []string{"--http-port", "9000", "--grpc-port", "9000"}, wantErr: []string{"grpc.port"}},
+		{
+			name:    "every invalid setting",
+			args:    []string{"--log-level", "loud", "--mongodb-uri", "localhost", "--optimizer-improvers", "three_opt", "--tracing-sample-ratio", "2", "--shutdown-timeout", "0s", "--drain-delay", "-1s", "--shift-start", "25h"},
+			wantErr: []string{"log_level", "mongodb.uri", "three_opt", "tracing.sample_ratio", "shutdown_timeout", "drain_delay", "shift_start"},
+		},
+		{name: "invalid capacity", file: "vehicle_capacities:\n  van: {max_weight_kg: 900}\n", wantErr: []string{"vehicle_capacities.van"}},
+		{name: "unknown vehicle type", file: "vehicle_capacities:\n  boat: {max_weight_kg: 1, max_volume_m3: 1}\n", wantErr: []string{"boat"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			args := tt.args
+			if tt.file != "" {
+				args = append([]string{"--config", writeFile(t, tt.file)}, args...)
+			}
+
+			_, _, err := Load(args)
+			if err == nil {
+				t.Fatal("Load() error = nil, want an error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Load() error = %v, want it to mention %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_Print(t *testing.T) {
+	cfg := Default()
+	cfg.Mongo.URI = "mongodb://admin:s3cret@db:27017/?replicaSet=rs0"
+
+	var out bytes.Buffer
+	if err := cfg.Print(&out); err != nil {
+		t.Fatalf("Print: %v", err)
+	}
+	if strings.Contains(out.String(), "s3cret") || !strings.Contains(out.String(), "mongodb://admin:xxxxx@db:27017") {
+		t.Errorf("printed config = %s, want the password redacted", out.String())
+	}
+	if cfg.Mongo.URI != "mongodb://admin:s3cret@db:27017/?replicaSet=rs0" {
+		t.Errorf("Mongo.URI = %q, want it left untouched", cfg.Mongo.URI)
+	}
+
+	// The printed configuration loads back to the same settings
+	path := writeFile(t, out.String())
+	loaded, _, err := Load([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("Load printed config: %v", err)
+	}
+	if loaded.HTTP != cfg.HTTP || loaded.Health != cfg.Health || loaded.Metrics != cfg.Metrics || loaded.Features != cfg.Features {
+		t.Errorf("loaded = %+v, want %+v", loaded, cfg)
+	}
+}
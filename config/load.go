@@ -0,0 +1,233 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// envPrefix starts the name of every environment variable read, keeping clear of variables such as
+// ENV that shells and other programs already give a meaning
+const envPrefix = "DELIVERY_"
+
+// configFileEnv names the YAML file to load when the --config flag is not given
+const configFileEnv = envPrefix + "CONFIG_FILE"
+
+// Load builds the configuration from the defaults, the YAML file named by --config or
+// DELIVERY_CONFIG_FILE, the environment and the flags in args, in increasing order of precedence,
+// and validates it. Every flag can also be set with the environment variable of the same name in
+// upper snake case after the DELIVERY_ prefix, e.g. --mongodb-uri with DELIVERY_MONGODB_URI and
+// --env with DELIVERY_ENV; a variable set to an empty value applies too, clearing the setting.
+// Load returns the arguments left after the flags, which name the command to run.
+func Load(args []string) (*Config, []string, error) {
+	// Parse the flags into a scratch configuration first, to find the file and the flags that
+	// must be applied last
+	var configFile string
+	flags := Default().flagSet(&configFile)
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	set := map[string]string{}
+	flags.Visit(func(f *flag.Flag) {
+		set[f.Name] = f.Value.String()
+	})
+	if configFile == "" {
+		configFile = os.Getenv(configFileEnv)
+	}
+
+	cfg := Default()
+	if configFile != "" {
+		if err := cfg.loadFile(configFile); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	layer := cfg.flagSet(&configFile)
+	var errs []error
+	layer.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+		name := envName(f.Name)
+		if value, ok := os.LookupEnv(name); ok {
+			if err := f.Value.Set(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+		}
+	})
+	for name, value := range set {
+		if err := layer.Set(name, value); err != nil {
+			errs = append(errs, fmt.Errorf("--%s: %w", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, flags.Args(), nil
+}
+
+// loadFile overrides the configuration with the settings present in a YAML file
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	if c.VehicleCapacities == nil {
+		c.VehicleCapacities = models.VehicleCapacities{}
+	}
+	return nil
+}
+
+// flagSet binds a flag to every setting of c, plus --config to configFile
+func (c *Config) flagSet(configFile *string) *flag.FlagSet {
+	fs := flag.NewFlagSet("deliveryPlannerGolang", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] [migrate [status] | config print]\n\n", fs.Name())
+		fmt.Fprintln(fs.Output(), "Every flag can also be set with its environment variable, e.g. --http-port with "+envName("http-port")+".")
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(configFile, "config", "", "YAML configuration file (env "+configFileEnv+")")
+	fs.StringVar(&c.Environment, "env", c.Environment, "deployment environment")
+	fs.StringVar(&c.StorageBackend, "storage-backend", c.StorageBackend, "storage backend: mongodb or memory")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "lowest log level: debug, info, warn or error")
+
+	fs.IntVar(&c.HTTP.Port, "http-port", c.HTTP.Port, "HTTP server port")
+	fs.IntVar(&c.GRPC.Port, "grpc-port", c.GRPC.Port, "gRPC server port")
+
+	fs.StringVar(&c.Mongo.URI, "mongodb-uri", c.Mongo.URI, "MongoDB connection string")
+	fs.StringVar(&c.Mongo.Database, "mongodb-db", c.Mongo.Database, "MongoDB database")
+	fs.Uint64Var(&c.Mongo.MinPoolSize, "mongodb-min-pool-size", c.Mongo.MinPoolSize, "minimum MongoDB connections kept open")
+	fs.Uint64Var(&c.Mongo.MaxPoolSize, "mongodb-max-pool-size", c.Mongo.MaxPoolSize, "maximum MongoDB connections")
+	fs.DurationVar(&c.Mongo.ConnectTimeout, "mongodb-connect-timeout", c.Mongo.ConnectTimeout, "MongoDB connection timeout")
+	fs.DurationVar(&c.Mongo.ServerSelectionTimeout, "mongodb-server-selection-timeout", c.Mongo.ServerSelectionTimeout, "MongoDB server selection timeout")
+	fs.DurationVar(&c.Mongo.Timeout, "mongodb-timeout", c.Mongo.Timeout, "MongoDB operation timeout, 0 for none")
+
+	fs.StringVar(&c.Optimizer.Constructor, "optimizer-constructor", c.Optimizer.Constructor, "tour constructor, empty to keep the order stops were added in")
+	fs.Var((*listValue)(&c.Optimizer.Improvers), "optimizer-improvers", "comma-separated tour improvers, applied in order")
+
+	vehicleTypes := make([]models.VehicleType, 0, len(models.DefaultVehicleCapacities))
+	for vehicleType := range models.DefaultVehicleCapacities {
+		vehicleTypes = append(vehicleTypes, vehicleType)
+	}
+	slices.Sort(vehicleTypes)
+	for _, vehicleType := range vehicleTypes {
+		fs.Var(&capacityValue{capacities: c.VehicleCapacities, vehicleType: vehicleType, weight: true},
+			string(vehicleType)+"-max-weight-kg", "maximum load of a "+string(vehicleType)+" in kilograms")
+		fs.Var(&capacityValue{capacities: c.VehicleCapacities, vehicleType: vehicleType},
+			string(vehicleType)+"-max-volume-m3", "maximum load of a "+string(vehicleType)+" in cubic meters")
+	}
+
+	fs.IntVar(&c.MaxDeliveryAttempts, "max-delivery-attempts", c.MaxDeliveryAttempts, "failed attempts after which a package is returned to the sender")
+	fs.DurationVar(&c.ShiftStart, "shift-start", c.ShiftStart, "time of day drivers set off, as an offset from midnight, for routes dated without one")
+	fs.StringVar(&c.BlobStorePath, "blob-store-path", c.BlobStorePath, "directory of the proof-of-delivery files")
+	fs.DurationVar(&c.DrainDelay, "drain-delay", c.DrainDelay, "how long the servers keep serving, reporting not ready, once the shutdown starts")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "how long the servers get to drain on shutdown")
+
+	fs.DurationVar(&c.Health.CheckInterval, "health-check-interval", c.Health.CheckInterval, "how often the gRPC health status is recomputed")
+	fs.DurationVar(&c.Metrics.RefreshInterval, "metrics-refresh-interval", c.Metrics.RefreshInterval, "how often the business gauges are recomputed")
+	fs.StringVar(&c.Tracing.Exporter, "tracing-exporter", c.Tracing.Exporter, "span exporter: none, otlp, stdout or file")
+	fs.StringVar(&c.Tracing.File, "tracing-file", c.Tracing.File, "file the file span exporter writes to")
+	fs.Float64Var(&c.Tracing.SampleRatio, "tracing-sample-ratio", c.Tracing.SampleRatio, "fraction of new traces recorded")
+
+	fs.BoolVar(&c.Features.AutoMigrate, "auto-migrate", c.Features.AutoMigrate, "apply pending MongoDB migrations at startup")
+	fs.BoolVar(&c.Features.Metrics, "metrics-enabled", c.Features.Metrics, "serve Prometheus metrics on /metrics")
+	fs.BoolVar(&c.Features.GRPCReflection, "grpc-reflection", c.Features.GRPCReflection, "register the gRPC reflection service")
+	return fs
+}
+
+// envName returns the environment variable of a flag, e.g. DELIVERY_MONGODB_URI for mongodb-uri
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// Print writes the configuration as YAML, with the password of the MongoDB URI redacted
+func (c *Config) Print(w io.Writer) error {
+	printed := *c
+	printed.Mongo.URI = redactURI(c.Mongo.URI)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&printed); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// uriPassword matches the password in the user information of a connection string
+var uriPassword = regexp.MustCompile(`(://[^:/@]*:)[^@/]*@`)
+
+func redactURI(uri string) string {
+	return uriPassword.ReplaceAllString(uri, "${1}xxxxx@")
+}
+
+// listValue is a comma-separated list flag
+type listValue []string
+
+func (l *listValue) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listValue) Set(value string) error {
+	*l = nil
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// capacityValue is the flag of one limit of a vehicle type's capacity
+type capacityValue struct {
+	capacities  models.VehicleCapacities
+	vehicleType models.VehicleType
+	weight      bool
+}
+
+func (v *capacityValue) String() string {
+	if v.capacities == nil {
+		return ""
+	}
+	capacity := v.capacities[v.vehicleType]
+	if v.weight {
+		return strconv.FormatFloat(capacity.MaxWeightKg, 'g', -1, 64)
+	}
+	return strconv.FormatFloat(capacity.MaxVolumeM3, 'g', -1, 64)
+}
+
+func (v *capacityValue) Set(value string) error {
+	limit, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	capacity := v.capacities[v.vehicleType]
+	if v.weight {
+		capacity.MaxWeightKg = limit
+	} else {
+		capacity.MaxVolumeM3 = limit
+	}
+	v.capacities[v.vehicleType] = capacity
+	return nil
+}
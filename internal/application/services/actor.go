@@ -0,0 +1,24 @@
+package services
+
+import "context"
+
+// SystemActor is recorded for changes made without an identified caller
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// WithActor returns a context that attributes changes to the given actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor responsible for the current request
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+	return SystemActor
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"errors"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
@@ -12,12 +11,12 @@ import (
 
 // DriverService handles driver business logic
 type DriverService struct {
-	driverRepo *repositories.DriverRepository
-	routeRepo  *repositories.RouteRepository
+	driverRepo repositories.DriverRepository
+	routeRepo  repositories.RouteRepository
 }
 
 // NewDriverService creates a new driver service
-func NewDriverService(driverRepo *repositories.DriverRepository, routeRepo *repositories.RouteRepository) *DriverService {
+func NewDriverService(driverRepo repositories.DriverRepository, routeRepo repositories.RouteRepository) *DriverService {
 	return &DriverService{
 		driverRepo: driverRepo,
 		routeRepo:  routeRepo,
@@ -25,11 +24,22 @@ func NewDriverService(driverRepo *repositories.DriverRepository, routeRepo *repo
 }
 
 // CreateDriver creates a new driver
-func (s *DriverService) CreateDriver(ctx context.Context, name string, vehicleType models.VehicleType) (*models.Driver, error) {
+func (s *DriverService) CreateDriver(ctx context.Context, name string, vehicleType models.VehicleType, capacityOverride *models.VehicleCapacity) (_ *models.Driver, err error) {
+	ctx, span := startSpan(ctx, "DriverService.CreateDriver")
+	defer endSpan(span, &err)
+
+	if !vehicleType.IsValid() {
+		return nil, models.Validationf("unknown vehicle type %q", vehicleType)
+	}
+
 	driver := &models.Driver{
-		Name:        name,
-		VehicleType: vehicleType,
-		Active:      true,
+		Name:             name,
+		VehicleType:      vehicleType,
+		Active:           true,
+		CapacityOverride: capacityOverride,
+	}
+	if err := driver.Validate(); err != nil {
+		return nil, err
 	}
 
 	if err := s.driverRepo.Create(ctx, driver); err != nil {
@@ -40,25 +50,49 @@ func (s *DriverService) CreateDriver(ctx context.Context, name string, vehicleTy
 }
 
 // GetDriver retrieves a driver by ID
-func (s *DriverService) GetDriver(ctx context.Context, id primitive.ObjectID) (*models.Driver, error) {
+func (s *DriverService) GetDriver(ctx context.Context, id primitive.ObjectID) (_ *models.Driver, err error) {
+	ctx, span := startSpan(ctx, "DriverService.GetDriver")
+	defer endSpan(span, &err)
+
 	return s.driverRepo.GetByID(ctx, id)
 }
 
-// ListDrivers retrieves all drivers
-func (s *DriverService) ListDrivers(ctx context.Context) ([]*models.Driver, error) {
-	return s.driverRepo.List(ctx)
+// ListDrivers retrieves one page of the drivers matching the filter
+func (s *DriverService) ListDrivers(ctx context.Context, filter repositories.DriverFilter, page repositories.PageRequest) (_ *repositories.Page[models.Driver], err error) {
+	ctx, span := startSpan(ctx, "DriverService.ListDrivers")
+	defer endSpan(span, &err)
+
+	if filter.VehicleType != "" && !filter.VehicleType.IsValid() {
+		return nil, models.Validationf("unknown vehicle type %q", filter.VehicleType)
+	}
+	return s.driverRepo.List(ctx, filter, page)
 }
 
-// UpdateDriver updates a driver
-func (s *DriverService) UpdateDriver(ctx context.Context, id primitive.ObjectID, name string, vehicleType models.VehicleType, active bool) (*models.Driver, error) {
+// UpdateDriver updates a driver; a non-zero version makes it fail when the driver changed since that version
+func (s *DriverService) UpdateDriver(ctx context.Context, id primitive.ObjectID, version int64, name string, vehicleType models.VehicleType, active bool, capacityOverride *models.VehicleCapacity) (_ *models.Driver, err error) {
+	ctx, span := startSpan(ctx, "DriverService.UpdateDriver")
+	defer endSpan(span, &err)
+
+	if !vehicleType.IsValid() {
+		return nil, models.Validationf("unknown vehicle type %q", vehicleType)
+	}
+
 	driver, err := s.driverRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if version != 0 {
+		driver.Version = version
+	}
 
 	driver.Name = name
 	driver.VehicleType = vehicleType
 	driver.Active = active
+	driver.CapacityOverride = capacityOverride
+
+	if err := driver.Validate(); err != nil {
+		return nil, err
+	}
 
 	if err := s.driverRepo.Update(ctx, driver); err != nil {
 		return nil, err
@@ -67,17 +101,21 @@ func (s *DriverService) UpdateDriver(ctx context.Context, id primitive.ObjectID,
 	return driver, nil
 }
 
-// DeleteDriver deletes a driver
-func (s *DriverService) DeleteDriver(ctx context.Context, id primitive.ObjectID) error {
-	// Check if driver has any active routes
-	routes, err := s.routeRepo.List(ctx)
-	if err != nil {
-		return err
-	}
+// unfinishedRouteStatuses are the statuses of the routes a driver still has to drive
+var unfinishedRouteStatuses = []models.RouteStatus{models.RouteStatusPending, models.RouteStatusActive, models.RouteStatusSuspended}
+
+// DeleteDriver deletes a driver, unless the driver still has routes that are not completed or cancelled
+func (s *DriverService) DeleteDriver(ctx context.Context, id primitive.ObjectID) (err error) {
+	ctx, span := startSpan(ctx, "DriverService.DeleteDriver")
+	defer endSpan(span, &err)
 
-	for _, route := range routes {
-		if route.DriverID == id && route.Status == models.RouteStatusActive {
-			return errors.New("cannot delete driver with active routes")
+	for _, status := range unfinishedRouteStatuses {
+		routes, err := s.routeRepo.List(ctx, repositories.RouteFilter{DriverID: id, Status: status}, repositories.PageRequest{Limit: 1})
+		if err != nil {
+			return err
+		}
+		if len(routes.Items) > 0 {
+			return models.Conflictf("cannot delete driver with %s routes", status)
 		}
 	}
 
@@ -85,18 +123,9 @@ func (s *DriverService) DeleteDriver(ctx context.Context, id primitive.ObjectID)
 }
 
 // GetDriverRoutes retrieves all routes for a driver
-func (s *DriverService) GetDriverRoutes(ctx context.Context, driverID primitive.ObjectID) ([]*models.Route, error) {
-	routes, err := s.routeRepo.List(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	var driverRoutes []*models.Route
-	for _, route := range routes {
-		if route.DriverID == driverID {
-			driverRoutes = append(driverRoutes, route)
-		}
-	}
+func (s *DriverService) GetDriverRoutes(ctx context.Context, driverID primitive.ObjectID) (_ []*models.Route, err error) {
+	ctx, span := startSpan(ctx, "DriverService.GetDriverRoutes")
+	defer endSpan(span, &err)
 
-	return driverRoutes, nil
+	return s.routeRepo.GetByDriverID(ctx, driverID)
 }
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func TestDriverService_CreateDriver(t *testing.T) {
+	tests := []struct {
+		name        string
+		vehicleType models.VehicleType
+		capacity    *models.VehicleCapacity
+		wantErr     bool
+	}{
+		{name: "without capacity override", vehicleType: models.VehicleTypeVan},
+		{name: "with capacity override", vehicleType: models.VehicleTypeVan, capacity: &models.VehicleCapacity{MaxWeightKg: 100, MaxVolumeM3: 1}},
+		{name: "invalid capacity override", vehicleType: models.VehicleTypeVan, capacity: &models.VehicleCapacity{MaxWeightKg: -1, MaxVolumeM3: 1}, wantErr: true},
+		{name: "unknown vehicle type", vehicleType: "spaceship", wantErr: true},
+		{name: "missing vehicle type", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+
+			driver, err := env.driverService.CreateDriver(context.Background(), "Ann", tt.vehicleType, tt.capacity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateDriver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, models.ErrValidation) {
+				t.Errorf("CreateDriver() error = %v, want a validation error", err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if driver.ID.IsZero() || !driver.Active {
+				t.Errorf("CreateDriver() = %+v, want a stored active driver", driver)
+			}
+		})
+	}
+}
+
+func TestDriverService_GetDriver(t *testing.T) {
+	env := newTestEnv(t)
+	driver := env.createDriver(t, models.VehicleTypeBike)
+
+	tests := []struct {
+		name    string
+		id      primitive.ObjectID
+		wantErr bool
+	}{
+		{name: "existing driver", id: driver.ID},
+		{name: "unknown driver", id: primitive.NewObjectID(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := env.driverService.GetDriver(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDriver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID != tt.id {
+				t.Errorf("GetDriver() ID = %s, want %s", got.ID.Hex(), tt.id.Hex())
+			}
+		})
+	}
+}
+
+func TestDriverService_ListDrivers(t *testing.T) {
+	active, inactive := true, false
+
+	tests := []struct {
+		name      string
+		filter    repositories.DriverFilter
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "no filter", wantCount: 3},
+		{name: "by vehicle type", filter: repositories.DriverFilter{VehicleType: models.VehicleTypeVan}, wantCount: 2},
+		{name: "active drivers", filter: repositories.DriverFilter{Active: &active}, wantCount: 2},
+		{name: "inactive vans", filter: repositories.DriverFilter{VehicleType: models.VehicleTypeVan, Active: &inactive}, wantCount: 1},
+		{name: "unknown vehicle type", filter: repositories.DriverFilter{VehicleType: "boat"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			env.createDriver(t, models.VehicleTypeBike)
+			env.createDriver(t, models.VehicleTypeVan)
+			idle := env.createDriver(t, models.VehicleTypeVan)
+			if _, err := env.driverService.UpdateDriver(ctx, idle.ID, 0, idle.Name, idle.VehicleType, false, nil); err != nil {
+				t.Fatalf("UpdateDriver: %v", err)
+			}
+
+			page, err := env.driverService.ListDrivers(ctx, tt.filter, repositories.PageRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListDrivers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(page.Items) != tt.wantCount {
+				t.Errorf("ListDrivers() returned %d drivers, want %d", len(page.Items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestDriverService_ListDrivers_Pagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		descending bool
+	}{
+		{name: "oldest first"},
+		{name: "newest first", descending: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var created []primitive.ObjectID
+			for i := 0; i < 5; i++ {
+				created = append(created, env.createDriver(t, models.VehicleTypeVan).ID)
+			}
+			if tt.descending {
+				slices.Reverse(created)
+			}
+
+			var listed []primitive.ObjectID
+			page := repositories.PageRequest{Limit: 2, Descending: tt.descending}
+			for pages := 1; ; pages++ {
+				result, err := env.driverService.ListDrivers(ctx, repositories.DriverFilter{}, page)
+				if err != nil {
+					t.Fatalf("ListDrivers() error = %v", err)
+				}
+				for _, driver := range result.Items {
+					listed = append(listed, driver.ID)
+				}
+				if result.NextCursor == "" {
+					if pages != 3 {
+						t.Errorf("listed %d pages, want 3", pages)
+					}
+					break
+				}
+				page.Cursor = result.NextCursor
+			}
+
+			if !slices.Equal(listed, created) {
+				t.Errorf("listed %v, want %v", listed, created)
+			}
+		})
+	}
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		env := newTestEnv(t)
+		_, err := env.driverService.ListDrivers(context.Background(), repositories.DriverFilter{}, repositories.PageRequest{Cursor: "nope"})
+		if !errors.Is(err, models.ErrValidation) {
+			t.Errorf("ListDrivers() error = %v, want a validation error", err)
+		}
+	})
+}
+
+func TestDriverService_UpdateDriver(t *testing.T) {
+	tests := []struct {
+		name        string
+		unknown     bool
+		vehicleType models.VehicleType
+		capacity    *models.VehicleCapacity
+		version     int64
+		concurrent  bool
+		wantErr     bool
+	}{
+		{name: "updates fields"},
+		{name: "updates the read version", version: 1},
+		{name: "unknown driver", unknown: true, wantErr: true},
+		{name: "invalid capacity override", capacity: &models.VehicleCapacity{MaxWeightKg: 1, MaxVolumeM3: -1}, wantErr: true},
+		{name: "unknown vehicle type", vehicleType: "spaceship", wantErr: true},
+		{name: "changed since it was read", version: 1, concurrent: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			id := env.createDriver(t, models.VehicleTypeVan).ID
+			if tt.unknown {
+				id = primitive.NewObjectID()
+			}
+			if tt.concurrent {
+				if _, err := env.driverService.UpdateDriver(context.Background(), id, 0, "Other", models.VehicleTypeVan, true, nil); err != nil {
+					t.Fatalf("concurrent UpdateDriver: %v", err)
+				}
+			}
+
+			vehicleType := models.VehicleTypeTruck
+			if tt.vehicleType != "" {
+				vehicleType = tt.vehicleType
+			}
+
+			driver, err := env.driverService.UpdateDriver(context.Background(), id, tt.version, "Renamed", vehicleType, false, tt.capacity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateDriver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if stored, _ := env.drivers.GetByID(context.Background(), id); stored != nil && stored.VehicleType != models.VehicleTypeVan {
+					t.Errorf("stored vehicle type = %s, want it unchanged", stored.VehicleType)
+				}
+				return
+			}
+
+			stored, _ := env.drivers.GetByID(context.Background(), driver.ID)
+			if stored.Name != "Renamed" || stored.VehicleType != models.VehicleTypeTruck || stored.Active || stored.Version != 2 {
+				t.Errorf("stored driver = %+v, want the updated fields at version 2", stored)
+			}
+		})
+	}
+}
+
+func TestDriverService_DeleteDriver(t *testing.T) {
+	tests := []struct {
+		name        string
+		routeStatus models.RouteStatus
+		wantErr     bool
+	}{
+		{name: "driver without routes"},
+		{name: "driver with a pending route", routeStatus: models.RouteStatusPending, wantErr: true},
+		{name: "driver with an active route", routeStatus: models.RouteStatusActive, wantErr: true},
+		{name: "driver with a suspended route", routeStatus: models.RouteStatusSuspended, wantErr: true},
+		{name: "driver with a completed route", routeStatus: models.RouteStatusCompleted},
+		{name: "driver with a cancelled route", routeStatus: models.RouteStatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			driver := env.createDriver(t, models.VehicleTypeVan)
+			if tt.routeStatus != "" {
+				route := models.NewRoute(driver.ID, testDate, testDepot)
+				route.Status = tt.routeStatus
+				if err := env.routes.Create(ctx, route); err != nil {
+					t.Fatalf("create route: %v", err)
+				}
+			}
+
+			err := env.driverService.DeleteDriver(ctx, driver.ID)
+			if (err != nil) != tt.wantErr || tt.wantErr && !errors.Is(err, models.ErrConflict) {
+				t.Fatalf("DeleteDriver() error = %v, want a conflict %v", err, tt.wantErr)
+			}
+
+			_, getErr := env.drivers.GetByID(ctx, driver.ID)
+			if deleted := getErr != nil; deleted == tt.wantErr {
+				t.Errorf("driver deleted = %v, want %v", deleted, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDriverService_GetDriverRoutes(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	busy := env.createDriver(t, models.VehicleTypeVan)
+	idle := env.createDriver(t, models.VehicleTypeVan)
+	for i := 0; i < 2; i++ {
+		if _, err := env.routeService.CreateRoute(ctx, busy.ID, testDate, testDepot); err != nil {
+			t.Fatalf("CreateRoute: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		driverID primitive.ObjectID
+		want     int
+	}{
+		{name: "driver with routes", driverID: busy.ID, want: 2},
+		{name: "driver without routes", driverID: idle.ID, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes, err := env.driverService.GetDriverRoutes(ctx, tt.driverID)
+			if err != nil {
+				t.Fatalf("GetDriverRoutes() error = %v", err)
+			}
+			if len(routes) != tt.want {
+				t.Errorf("GetDriverRoutes() returned %d routes, want %d", len(routes), tt.want)
+			}
+			for _, route := range routes {
+				if route.DriverID != tt.driverID {
+					t.Errorf("GetDriverRoutes() returned a route of driver %s", route.DriverID.Hex())
+				}
+			}
+		})
+	}
+}
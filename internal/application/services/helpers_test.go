@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/memory"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/storage/filesystem"
+)
+
+const (
+	testMaxDeliveryAttempts = 2
+	testShiftStart          = 8 * time.Hour
+)
+
+// testEnv wires every service to in-memory repositories and a temporary blob store
+type testEnv struct {
+	drivers  *memory.DriverRepository
+	packages *memory.PackageRepository
+	routes   *memory.RouteRepository
+	events   *memory.PackageEventRepository
+	plans    *memory.PlanRepository
+	uow      *recordingUnitOfWork
+
+	driverService   *DriverService
+	packageService  *PackageService
+	routeService    *RouteService
+	planningService *PlanningService
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	blobs, err := filesystem.NewBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewBlobStore: %v", err)
+	}
+
+	env := &testEnv{
+		drivers:  memory.NewDriverRepository(),
+		packages: memory.NewPackageRepository(),
+		routes:   memory.NewRouteRepository(),
+		events:   memory.NewPackageEventRepository(),
+		plans:    memory.NewPlanRepository(),
+		uow:      &recordingUnitOfWork{},
+	}
+	optimizer := optimization.NewDefaultOptimizer()
+	env.driverService = NewDriverService(env.drivers, env.routes)
+	env.packageService = NewPackageService(env.packages, env.events, blobs)
+	env.routeService = NewRouteService(env.routes, env.drivers, env.packages, env.events, env.uow, blobs, optimizer, models.DefaultVehicleCapacities, testMaxDeliveryAttempts, testShiftStart)
+	env.planningService = NewPlanningService(env.plans, env.drivers, env.packages, env.routeService, optimization.NewFleetPlanner(optimizer), models.DefaultVehicleCapacities)
+	return env
+}
+
+// recordingUnitOfWork runs units in-process like the in-memory backend and counts the outermost
+// ones; nested units join the enclosing one as MongoDB transactions do
+type recordingUnitOfWork struct {
+	units int
+}
+
+// unitKey marks the context of a running unit
+type unitKey struct{}
+
+func (u *recordingUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(unitKey{}) != nil {
+		return fn(ctx)
+	}
+	u.units++
+	return fn(context.WithValue(ctx, unitKey{}, true))
+}
+
+var testDepot = &models.Location{Latitude: 40.4168, Longitude: -3.7038}
+
+// testDate is the delivery day used by every fixture
+var testDate = time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)
+
+func (e *testEnv) createDriver(t *testing.T, vehicleType models.VehicleType) *models.Driver {
+	t.Helper()
+
+	driver, err := e.driverService.CreateDriver(context.Background(), "Driver "+string(vehicleType), vehicleType, nil)
+	if err != nil {
+		t.Fatalf("CreateDriver: %v", err)
+	}
+	return driver
+}
+
+// createPackage creates a small package a few hundred metres from the depot
+func (e *testEnv) createPackage(t *testing.T, trackingNumber string, offset float64) *models.Package {
+	t.Helper()
+
+	location := &models.Location{Latitude: testDepot.Latitude + offset, Longitude: testDepot.Longitude + offset}
+	pkg, err := e.packageService.CreatePackage(context.Background(), trackingNumber, "Customer", "Street 1", "600000000", 1, 0.01, location, nil, 5)
+	if err != nil {
+		t.Fatalf("CreatePackage: %v", err)
+	}
+	return pkg
+}
+
+// createRoute creates a pending route for a new van driver loaded with the given number of packages
+func (e *testEnv) createRoute(t *testing.T, packageCount int) (*models.Route, []*models.Package) {
+	t.Helper()
+	ctx := context.Background()
+
+	driver := e.createDriver(t, models.VehicleTypeVan)
+	route, err := e.routeService.CreateRoute(ctx, driver.ID, testDate, testDepot)
+	if err != nil {
+		t.Fatalf("CreateRoute: %v", err)
+	}
+
+	packages := make([]*models.Package, packageCount)
+	ids := make([]primitive.ObjectID, packageCount)
+	for i := range packages {
+		packages[i] = e.createPackage(t, primitive.NewObjectID().Hex(), 0.005*float64(i+1))
+		ids[i] = packages[i].ID
+	}
+	if packageCount > 0 {
+		if err := e.routeService.AddPackagesToRoute(ctx, route.ID, ids); err != nil {
+			t.Fatalf("AddPackagesToRoute: %v", err)
+		}
+	}
+
+	return e.getRoute(t, route.ID), packages
+}
+
+// createActiveRoute creates a route with the given number of packages and starts it
+func (e *testEnv) createActiveRoute(t *testing.T, packageCount int) (*models.Route, []*models.Package) {
+	t.Helper()
+
+	route, packages := e.createRoute(t, packageCount)
+	if err := e.routeService.UpdateRouteStatus(context.Background(), route.ID, models.RouteStatusActive); err != nil {
+		t.Fatalf("UpdateRouteStatus: %v", err)
+	}
+	return e.getRoute(t, route.ID), packages
+}
+
+func (e *testEnv) getRoute(t *testing.T, id primitive.ObjectID) *models.Route {
+	t.Helper()
+
+	route, err := e.routes.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get route: %v", err)
+	}
+	return route
+}
+
+func (e *testEnv) getPackage(t *testing.T, id primitive.ObjectID) *models.Package {
+	t.Helper()
+
+	pkg, err := e.packages.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get package: %v", err)
+	}
+	return pkg
+}
+
+// eventTypes lists the types of a package's recorded events in order
+func (e *testEnv) eventTypes(t *testing.T, id primitive.ObjectID) []models.PackageEventType {
+	t.Helper()
+
+	events, err := e.events.ListByPackageID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	types := make([]models.PackageEventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	return types
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+)
+
+// recordStatusChange appends the event for a package that has just moved away from the given status
+func recordStatusChange(ctx context.Context, eventRepo repositories.PackageEventRepository, pkg *models.Package, from models.PackageStatus, routeID *primitive.ObjectID, location *models.Location, note string) error {
+	event := models.NewPackageStatusEvent(pkg, from, ActorFromContext(ctx))
+	event.RouteID = routeID
+	event.Location = location
+	event.Note = note
+	if err := eventRepo.Append(ctx, event); err != nil {
+		return err
+	}
+
+	metrics.RecordPackageStatus(pkg.CurrentStatus())
+	slog.InfoContext(ctx, "package status changed",
+		slog.String("package_id", pkg.ID.Hex()),
+		slog.String("from", string(from)),
+		slog.String("to", string(pkg.CurrentStatus())),
+		slog.String("actor", event.Actor),
+	)
+	return nil
+}
@@ -2,59 +2,94 @@ package services
 
 import (
 	"context"
-	"errors"
+	"slices"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/storage"
 )
 
 // PackageService handles package business logic
 type PackageService struct {
-	packageRepo *repositories.PackageRepository
+	packageRepo repositories.PackageRepository
+	eventRepo   repositories.PackageEventRepository
+	blobs       storage.BlobStore
 }
 
 // NewPackageService creates a new package service
-func NewPackageService(packageRepo *repositories.PackageRepository) *PackageService {
+func NewPackageService(packageRepo repositories.PackageRepository, eventRepo repositories.PackageEventRepository, blobs storage.BlobStore) *PackageService {
 	return &PackageService{
 		packageRepo: packageRepo,
+		eventRepo:   eventRepo,
+		blobs:       blobs,
 	}
 }
 
 // CreatePackage creates a new package
-func (s *PackageService) CreatePackage(ctx context.Context, trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64) (*models.Package, error) {
-	pkg := models.NewPackage(trackingNumber, customerName, customerAddress, customerPhone, weightKg, volumeM3)
+func (s *PackageService) CreatePackage(ctx context.Context, trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64, location *models.Location, deliveryWindow *models.TimeWindow, serviceDurationMin int) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "PackageService.CreatePackage")
+	defer endSpan(span, &err)
+
+	pkg := models.NewPackage(trackingNumber, customerName, customerAddress, customerPhone, weightKg, volumeM3, location, deliveryWindow, serviceDurationMin)
+	if err := pkg.Validate(); err != nil {
+		return nil, err
+	}
 
 	if err := s.packageRepo.Create(ctx, pkg); err != nil {
 		return nil, err
 	}
 
+	event := models.NewPackageEvent(pkg.ID, models.PackageEventCreated, ActorFromContext(ctx))
+	event.Location = pkg.Location
+	if err := s.eventRepo.Append(ctx, event); err != nil {
+		return nil, err
+	}
+
 	return pkg, nil
 }
 
 // GetPackage retrieves a package by ID
-func (s *PackageService) GetPackage(ctx context.Context, id primitive.ObjectID) (*models.Package, error) {
+func (s *PackageService) GetPackage(ctx context.Context, id primitive.ObjectID) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "PackageService.GetPackage")
+	defer endSpan(span, &err)
+
 	return s.packageRepo.GetByID(ctx, id)
 }
 
 // GetPackageByTrackingNumber retrieves a package by tracking number
-func (s *PackageService) GetPackageByTrackingNumber(ctx context.Context, trackingNumber string) (*models.Package, error) {
+func (s *PackageService) GetPackageByTrackingNumber(ctx context.Context, trackingNumber string) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "PackageService.GetPackageByTrackingNumber")
+	defer endSpan(span, &err)
+
 	return s.packageRepo.GetByTrackingNumber(ctx, trackingNumber)
 }
 
-// ListPackages retrieves all packages
-func (s *PackageService) ListPackages(ctx context.Context) ([]*models.Package, error) {
-	return s.packageRepo.List(ctx)
+// ListPackages retrieves one page of the packages matching the filter
+func (s *PackageService) ListPackages(ctx context.Context, filter repositories.PackageFilter, page repositories.PageRequest) (_ *repositories.Page[models.Package], err error) {
+	ctx, span := startSpan(ctx, "PackageService.ListPackages")
+	defer endSpan(span, &err)
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, models.Validationf("unknown package status %q", filter.Status)
+	}
+	return s.packageRepo.List(ctx, filter, page)
 }
 
-// UpdatePackage updates a package
-func (s *PackageService) UpdatePackage(ctx context.Context, id primitive.ObjectID, trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64) (*models.Package, error) {
+// UpdatePackage updates a package; a non-zero version makes it fail when the package changed since that version
+func (s *PackageService) UpdatePackage(ctx context.Context, id primitive.ObjectID, version int64, trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64, location *models.Location, deliveryWindow *models.TimeWindow, serviceDurationMin int) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "PackageService.UpdatePackage")
+	defer endSpan(span, &err)
+
 	pkg, err := s.packageRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if version != 0 {
+		pkg.Version = version
+	}
 
 	pkg.TrackingNumber = trackingNumber
 	pkg.CustomerName = customerName
@@ -62,41 +97,115 @@ func (s *PackageService) UpdatePackage(ctx context.Context, id primitive.ObjectI
 	pkg.CustomerPhone = customerPhone
 	pkg.WeightKg = weightKg
 	pkg.VolumeM3 = volumeM3
+	pkg.Location = location
+	pkg.DeliveryWindow = deliveryWindow
+	pkg.ServiceDurationMin = serviceDurationMin
 	pkg.UpdatedAt = time.Now()
 
+	if err := pkg.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := s.packageRepo.Update(ctx, pkg); err != nil {
 		return nil, err
 	}
 
+	if err := s.eventRepo.Append(ctx, models.NewPackageEvent(pkg.ID, models.PackageEventUpdated, ActorFromContext(ctx))); err != nil {
+		return nil, err
+	}
+
 	return pkg, nil
 }
 
-// DeletePackage deletes a package
-func (s *PackageService) DeletePackage(ctx context.Context, id primitive.ObjectID) error {
+// DeletePackage deletes a package that is pending or cancelled
+func (s *PackageService) DeletePackage(ctx context.Context, id primitive.ObjectID) (err error) {
+	ctx, span := startSpan(ctx, "PackageService.DeletePackage")
+	defer endSpan(span, &err)
+
 	pkg, err := s.packageRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	if pkg.Delivered {
-		return errors.New("cannot delete a delivered package")
+	// Packages on a route, or whose delivery is over, stay for the route and the history
+	if status := pkg.CurrentStatus(); status != models.PackageStatusPending && status != models.PackageStatusCancelled {
+		return models.Conflictf("cannot delete a package that is %s", status)
 	}
 
 	return s.packageRepo.Delete(ctx, id)
 }
 
-// MarkAsDelivered marks a package as delivered
-func (s *PackageService) MarkAsDelivered(ctx context.Context, id primitive.ObjectID) (*models.Package, error) {
+// GetProofOfDelivery retrieves the proof captured when a package was delivered, with its attachments
+func (s *PackageService) GetProofOfDelivery(ctx context.Context, id primitive.ObjectID) (_ *DeliveryProof, err error) {
+	ctx, span := startSpan(ctx, "PackageService.GetProofOfDelivery")
+	defer endSpan(span, &err)
+
 	pkg, err := s.packageRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if pkg.ProofOfDelivery == nil {
+		return nil, models.ErrProofOfDeliveryNotFound
+	}
 
-	pkg.MarkAsDelivered()
+	return loadProofOfDelivery(ctx, s.blobs, pkg.ProofOfDelivery)
+}
+
+// offRouteTransitions are the status changes a package can take on its own; the others follow its
+// route stop and are made through RouteService
+var offRouteTransitions = map[models.PackageStatus][]models.PackageStatus{
+	models.PackageStatusPending: {models.PackageStatusCancelled},
+	models.PackageStatusFailed:  {models.PackageStatusReturned},
+}
+
+// UpdatePackageStatus moves a package off any route to a new lifecycle status, noting where and why
+// in its history; assigning, dispatching, delivering and failing a package go through its route
+func (s *PackageService) UpdatePackageStatus(ctx context.Context, id primitive.ObjectID, status models.PackageStatus, location *models.Location, note string) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "PackageService.UpdatePackageStatus")
+	defer endSpan(span, &err)
+
+	if !status.IsValid() {
+		return nil, models.Validationf("unknown package status %q", status)
+	}
+
+	pkg, err := s.packageRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if location != nil {
+		if err := location.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	from := pkg.CurrentStatus()
+	if from.CanTransitionTo(status) && !slices.Contains(offRouteTransitions[from], status) {
+		return nil, models.Conflictf("a package moves from %s to %s through its route", from, status)
+	}
+	if err := pkg.TransitionTo(status); err != nil {
+		return nil, err
+	}
 
 	if err := s.packageRepo.Update(ctx, pkg); err != nil {
 		return nil, err
 	}
 
+	if err := recordStatusChange(ctx, s.eventRepo, pkg, from, nil, location, note); err != nil {
+		return nil, err
+	}
+
 	return pkg, nil
 }
+
+// GetPackageHistory retrieves the tracking events of a package, oldest first
+func (s *PackageService) GetPackageHistory(ctx context.Context, id primitive.ObjectID) (_ []*models.PackageEvent, err error) {
+	ctx, span := startSpan(ctx, "PackageService.GetPackageHistory")
+	defer endSpan(span, &err)
+
+	if _, err := s.packageRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.ListByPackageID(ctx, id)
+}
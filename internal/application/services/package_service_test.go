@@ -0,0 +1,408 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func TestPackageService_CreatePackage(t *testing.T) {
+	window := &models.TimeWindow{Earliest: testDate, Latest: testDate.Add(2 * time.Hour)}
+
+	tests := []struct {
+		name            string
+		location        *models.Location
+		window          *models.TimeWindow
+		serviceDuration int
+		wantErr         bool
+	}{
+		{name: "minimal package"},
+		{name: "with location and window", location: testDepot, window: window, serviceDuration: 5},
+		{name: "invalid location", location: &models.Location{Latitude: 91}, wantErr: true},
+		{name: "reversed window", window: &models.TimeWindow{Earliest: window.Latest, Latest: window.Earliest}, wantErr: true},
+		{name: "negative service duration", serviceDuration: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+
+			pkg, err := env.packageService.CreatePackage(context.Background(), "TRK-1", "Customer", "Street 1", "600000000", 2, 0.1, tt.location, tt.window, tt.serviceDuration)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreatePackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if pkg.ID.IsZero() || pkg.Status != models.PackageStatusPending {
+				t.Errorf("CreatePackage() = %+v, want a stored pending package", pkg)
+			}
+			if got := env.eventTypes(t, pkg.ID); !reflect.DeepEqual(got, []models.PackageEventType{models.PackageEventCreated}) {
+				t.Errorf("events = %v, want a single created event", got)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackage(t *testing.T) {
+	env := newTestEnv(t)
+	pkg := env.createPackage(t, "TRK-1", 0.01)
+
+	tests := []struct {
+		name    string
+		id      primitive.ObjectID
+		wantErr bool
+	}{
+		{name: "existing package", id: pkg.ID},
+		{name: "unknown package", id: primitive.NewObjectID(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := env.packageService.GetPackage(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.TrackingNumber != "TRK-1" {
+				t.Errorf("GetPackage() tracking number = %q, want TRK-1", got.TrackingNumber)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackageByTrackingNumber(t *testing.T) {
+	env := newTestEnv(t)
+	pkg := env.createPackage(t, "TRK-1", 0.01)
+	env.createPackage(t, "TRK-2", 0.02)
+
+	tests := []struct {
+		name           string
+		trackingNumber string
+		wantID         primitive.ObjectID
+		wantErr        bool
+	}{
+		{name: "known tracking number", trackingNumber: "TRK-1", wantID: pkg.ID},
+		{name: "unknown tracking number", trackingNumber: "TRK-9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := env.packageService.GetPackageByTrackingNumber(context.Background(), tt.trackingNumber)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPackageByTrackingNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID != tt.wantID {
+				t.Errorf("GetPackageByTrackingNumber() ID = %s, want %s", got.ID.Hex(), tt.wantID.Hex())
+			}
+		})
+	}
+}
+
+func TestPackageService_ListPackages(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    repositories.PackageFilter
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "no filter", wantCount: 3},
+		{name: "by status", filter: repositories.PackageFilter{Status: models.PackageStatusCancelled}, wantCount: 1},
+		{name: "by tracking prefix", filter: repositories.PackageFilter{TrackingPrefix: "EU-"}, wantCount: 2},
+		{name: "created in range", filter: repositories.PackageFilter{CreatedFrom: time.Now().Add(-time.Hour), CreatedTo: time.Now().Add(time.Hour)}, wantCount: 3},
+		{name: "created later", filter: repositories.PackageFilter{CreatedFrom: time.Now().Add(time.Hour)}, wantCount: 0},
+		{name: "unknown status", filter: repositories.PackageFilter{Status: "lost"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			env.createPackage(t, "EU-1", 0.01)
+			env.createPackage(t, "EU-2", 0.01)
+			cancelled := env.createPackage(t, "US-1", 0.01)
+			if _, err := env.packageService.UpdatePackageStatus(ctx, cancelled.ID, models.PackageStatusCancelled, nil, ""); err != nil {
+				t.Fatalf("UpdatePackageStatus: %v", err)
+			}
+
+			page, err := env.packageService.ListPackages(ctx, tt.filter, repositories.PageRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListPackages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(page.Items) != tt.wantCount {
+				t.Errorf("ListPackages() returned %d packages, want %d", len(page.Items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPackageService_UpdatePackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		unknown  bool
+		location *models.Location
+		wantErr  bool
+	}{
+		{name: "updates fields", location: testDepot},
+		{name: "unknown package", unknown: true, wantErr: true},
+		{name: "invalid location", location: &models.Location{Longitude: 181}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			id := env.createPackage(t, "TRK-1", 0.01).ID
+			if tt.unknown {
+				id = primitive.NewObjectID()
+			}
+
+			_, err := env.packageService.UpdatePackage(context.Background(), id, 0, "TRK-2", "New customer", "Street 2", "611111111", 3, 0.2, tt.location, nil, 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdatePackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			stored := env.getPackage(t, id)
+			if stored.TrackingNumber != "TRK-2" || stored.WeightKg != 3 || stored.ServiceDurationMin != 10 {
+				t.Errorf("stored package = %+v, want the updated fields", stored)
+			}
+			want := []models.PackageEventType{models.PackageEventCreated, models.PackageEventUpdated}
+			if got := env.eventTypes(t, id); !reflect.DeepEqual(got, want) {
+				t.Errorf("events = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPackageService_TrackingNumberUniqueness(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.createPackage(t, "TRK-1", 0.01)
+	other := env.createPackage(t, "TRK-2", 0.02)
+
+	_, err := env.packageService.CreatePackage(ctx, "TRK-1", "Customer", "Street 1", "600000000", 1, 0.01, testDepot, nil, 5)
+	if !errors.Is(err, models.ErrConflict) {
+		t.Errorf("CreatePackage() with a taken tracking number error = %v, want a conflict", err)
+	}
+
+	_, err = env.packageService.UpdatePackage(ctx, other.ID, 0, "TRK-1", "Customer", "Street 1", "600000000", 1, 0.01, testDepot, nil, 5)
+	if !errors.Is(err, models.ErrConflict) {
+		t.Errorf("UpdatePackage() to a taken tracking number error = %v, want a conflict", err)
+	}
+
+	if _, err := env.packageService.UpdatePackage(ctx, other.ID, 0, "TRK-2", "Renamed", "Street 1", "600000000", 1, 0.01, testDepot, nil, 5); err != nil {
+		t.Errorf("UpdatePackage() keeping its own tracking number error = %v", err)
+	}
+}
+
+func TestPackageService_DeletePackage(t *testing.T) {
+	tests := []struct {
+		name      string
+		assigned  bool
+		delivered bool
+		unknown   bool
+		wantErr   bool
+	}{
+		{name: "pending package"},
+		{name: "package on a route", assigned: true, wantErr: true},
+		{name: "delivered package", delivered: true, wantErr: true},
+		{name: "unknown package", unknown: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+
+			var id primitive.ObjectID
+			switch {
+			case tt.unknown:
+				id = primitive.NewObjectID()
+			case tt.assigned:
+				_, packages := env.createRoute(t, 1)
+				id = packages[0].ID
+			case tt.delivered:
+				route, packages := env.createActiveRoute(t, 1)
+				id = packages[0].ID
+				if _, err := env.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, id, true, nil); err != nil {
+					t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+				}
+			default:
+				id = env.createPackage(t, "TRK-1", 0.01).ID
+			}
+
+			err := env.packageService.DeletePackage(ctx, id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeletePackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if _, err := env.packages.GetByID(ctx, id); err == nil {
+					t.Error("package still exists after DeletePackage()")
+				}
+			}
+		})
+	}
+}
+
+func TestPackageService_GetProofOfDelivery(t *testing.T) {
+	tests := []struct {
+		name    string
+		proof   *DeliveryProof
+		wantErr error
+	}{
+		{
+			name: "delivered with proof",
+			proof: &DeliveryProof{
+				RecipientName: "Bob",
+				Signature:     &Attachment{ContentType: "image/png", Data: []byte("signature")},
+				Photo:         &Attachment{ContentType: "image/jpeg", Data: []byte("photo")},
+			},
+		},
+		{name: "delivered without proof", wantErr: models.ErrProofOfDeliveryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			_, packages := env.createActiveRoute(t, 1)
+			if _, err := env.routeService.DeliverPackage(ctx, packages[0].ID, tt.proof); err != nil {
+				t.Fatalf("DeliverPackage: %v", err)
+			}
+
+			got, err := env.packageService.GetProofOfDelivery(ctx, packages[0].ID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetProofOfDelivery() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProofOfDelivery() error = %v", err)
+			}
+
+			if got.RecipientName != tt.proof.RecipientName {
+				t.Errorf("recipient = %q, want %q", got.RecipientName, tt.proof.RecipientName)
+			}
+			if !reflect.DeepEqual(got.Signature, tt.proof.Signature) || !reflect.DeepEqual(got.Photo, tt.proof.Photo) {
+				t.Errorf("attachments = %+v / %+v, want the uploaded ones", got.Signature, got.Photo)
+			}
+		})
+	}
+}
+
+func TestPackageService_UpdatePackageStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		onActiveRoute bool
+		status        models.PackageStatus
+		wantErr       bool
+		wantErrIs     error
+		wantEvent     models.PackageEventType
+	}{
+		{name: "cancel a pending package", status: models.PackageStatusCancelled, wantEvent: models.PackageEventStatusChanged},
+		{name: "assign a pending package off a route", status: models.PackageStatusAssigned, wantErr: true, wantErrIs: models.ErrConflict},
+		{name: "deliver a pending package", status: models.PackageStatusDelivered, wantErr: true, wantErrIs: models.ErrInvalidTransition},
+		{name: "deliver a package out for delivery", onActiveRoute: true, status: models.PackageStatusDelivered, wantErr: true, wantErrIs: models.ErrConflict},
+		{name: "fail a package out for delivery", onActiveRoute: true, status: models.PackageStatusFailed, wantErr: true, wantErrIs: models.ErrConflict},
+		{name: "unknown status", status: "lost", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var id primitive.ObjectID
+			if tt.onActiveRoute {
+				_, packages := env.createActiveRoute(t, 1)
+				id = packages[0].ID
+			} else {
+				id = env.createPackage(t, "TRK-1", 0.01).ID
+			}
+			before := env.getPackage(t, id).Status
+
+			pkg, err := env.packageService.UpdatePackageStatus(ctx, id, tt.status, testDepot, "note")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdatePackageStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Fatalf("UpdatePackageStatus() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if tt.wantErr {
+				if stored := env.getPackage(t, id); stored.Status != before {
+					t.Errorf("stored status = %s, want it unchanged at %s", stored.Status, before)
+				}
+				return
+			}
+			if pkg.Status != tt.status {
+				t.Errorf("status = %s, want %s", pkg.Status, tt.status)
+			}
+
+			events, _ := env.events.ListByPackageID(ctx, id)
+			last := events[len(events)-1]
+			if last.Type != tt.wantEvent || last.FromStatus != models.PackageStatusPending || last.ToStatus != tt.status || last.Note != "note" || last.Location == nil {
+				t.Errorf("last event = %+v, want a %s event with the note and location", last, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackageHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   string
+		unknown bool
+		wantErr bool
+	}{
+		{name: "history attributed to the system"},
+		{name: "history attributed to the caller", actor: "dispatcher"},
+		{name: "unknown package", unknown: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := WithActor(context.Background(), tt.actor)
+			pkg, err := env.packageService.CreatePackage(ctx, "TRK-1", "Customer", "Street 1", "600000000", 1, 0.01, nil, nil, 0)
+			if err != nil {
+				t.Fatalf("CreatePackage: %v", err)
+			}
+			if _, err := env.packageService.UpdatePackageStatus(ctx, pkg.ID, models.PackageStatusCancelled, nil, ""); err != nil {
+				t.Fatalf("UpdatePackageStatus: %v", err)
+			}
+
+			id := pkg.ID
+			if tt.unknown {
+				id = primitive.NewObjectID()
+			}
+			events, err := env.packageService.GetPackageHistory(ctx, id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPackageHistory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			wantActor := tt.actor
+			if wantActor == "" {
+				wantActor = SystemActor
+			}
+			if len(events) != 2 || events[0].Type != models.PackageEventCreated || events[1].ToStatus != models.PackageStatusCancelled {
+				t.Fatalf("GetPackageHistory() = %+v, want created then cancelled", events)
+			}
+			for _, event := range events {
+				if event.Actor != wantActor {
+					t.Errorf("event actor = %q, want %q", event.Actor, wantActor)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// PlanningService handles fleet planning business logic
+type PlanningService struct {
+	planRepo     repositories.PlanRepository
+	driverRepo   repositories.DriverRepository
+	packageRepo  repositories.PackageRepository
+	routeService *RouteService
+	planner      *optimization.FleetPlanner
+	capacities   models.VehicleCapacities
+}
+
+// NewPlanningService creates a new planning service
+func NewPlanningService(planRepo repositories.PlanRepository, driverRepo repositories.DriverRepository, packageRepo repositories.PackageRepository, routeService *RouteService, planner *optimization.FleetPlanner, capacities models.VehicleCapacities) *PlanningService {
+	return &PlanningService{
+		planRepo:     planRepo,
+		driverRepo:   driverRepo,
+		packageRepo:  packageRepo,
+		routeService: routeService,
+		planner:      planner,
+		capacities:   capacities,
+	}
+}
+
+// PreviewPlan distributes the given packages across all active drivers and stores the result as a draft plan
+func (s *PlanningService) PreviewPlan(ctx context.Context, date time.Time, startLocation *models.Location, packageIDs []primitive.ObjectID) (_ *models.Plan, err error) {
+	ctx, span := startSpan(ctx, "PlanningService.PreviewPlan")
+	defer endSpan(span, &err)
+
+	plan := models.NewPlan(date, startLocation)
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	drivers, err := s.activeDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicles []optimization.Vehicle
+	activeDrivers := make(map[primitive.ObjectID]*models.Driver)
+	for _, driver := range drivers {
+		vehicles = append(vehicles, optimization.Vehicle{
+			ID:       driver.ID,
+			Capacity: s.capacities.ForDriver(driver),
+			SpeedKmh: driver.VehicleType.SpeedProfile().AverageSpeedKmh,
+		})
+		activeDrivers[driver.ID] = driver
+	}
+	if len(vehicles) == 0 {
+		return nil, models.Conflictf("there are no active drivers to plan for")
+	}
+
+	// Packages without coordinates cannot be placed on a map and are left for manual assignment
+	var stops []optimization.Stop
+	packages := make(map[primitive.ObjectID]*models.Package, len(packageIDs))
+	for _, id := range packageIDs {
+		if _, ok := packages[id]; ok {
+			return nil, models.Validationf("package %s is listed more than once", id.Hex())
+		}
+		pkg, err := s.packageRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if pkg == nil {
+			return nil, models.NotFoundf("package %s not found", id.Hex())
+		}
+		if pkg.CurrentStatus() != models.PackageStatusPending {
+			return nil, models.Conflictf("package %s is not pending: it is %s", id.Hex(), pkg.CurrentStatus())
+		}
+
+		packages[id] = pkg
+		if pkg.Location == nil {
+			plan.UnassignedPackageIDs = append(plan.UnassignedPackageIDs, id)
+			continue
+		}
+		stops = append(stops, optimization.Stop{
+			ID:              pkg.ID,
+			Location:        *pkg.Location,
+			WeightKg:        pkg.WeightKg,
+			VolumeM3:        pkg.VolumeM3,
+			Window:          pkg.DeliveryWindow,
+			ServiceDuration: pkg.ServiceDuration(),
+		})
+	}
+
+	problem := optimization.NewProblem(startLocation, stops)
+	problem.Departure = s.routeService.departure(date)
+	solution := s.planner.Plan(problem, vehicles)
+
+	for _, index := range solution.Unassigned {
+		plan.UnassignedPackageIDs = append(plan.UnassignedPackageIDs, stops[index].ID)
+	}
+
+	for _, vehicleTour := range solution.Tours {
+		if len(vehicleTour.Tour) == 0 {
+			continue
+		}
+
+		// Lay the stops out on a draft route so the preview carries the same estimates the
+		// committed route will have
+		driver := activeDrivers[vehicleTour.Vehicle.ID]
+		route := models.NewRoute(driver.ID, date, startLocation)
+		routePackages := make(map[primitive.ObjectID]*models.Package, len(vehicleTour.Tour))
+		for _, index := range vehicleTour.Tour {
+			route.AddPackage(stops[index].ID)
+			routePackages[stops[index].ID] = packages[stops[index].ID]
+		}
+		if err := s.routeService.updateEstimates(route, driver, routePackages); err != nil {
+			return nil, err
+		}
+
+		plannedRoute := models.PlannedRoute{
+			DriverID:            driver.ID,
+			VehicleType:         driver.VehicleType,
+			PackageIDs:          make([]primitive.ObjectID, len(route.Packages)),
+			LoadWeightKg:        route.LoadWeightKg,
+			LoadVolumeM3:        route.LoadVolumeM3,
+			EstimatedDistanceKm: route.EstimatedDistanceKm,
+			EstimatedTimeMin:    route.EstimatedTimeMin,
+		}
+		for i, stop := range route.Packages {
+			plannedRoute.PackageIDs[i] = stop.PackageID
+		}
+
+		plan.AddRoute(plannedRoute)
+	}
+
+	if err := s.planRepo.Create(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// GetPlan retrieves a plan by ID
+func (s *PlanningService) GetPlan(ctx context.Context, id primitive.ObjectID) (_ *models.Plan, err error) {
+	ctx, span := startSpan(ctx, "PlanningService.GetPlan")
+	defer endSpan(span, &err)
+
+	return s.planRepo.GetByID(ctx, id)
+}
+
+// ConfirmPlan commits a draft plan by creating one route per planned driver, as a single unit of work
+func (s *PlanningService) ConfirmPlan(ctx context.Context, id primitive.ObjectID) (_ *models.Plan, err error) {
+	ctx, span := startSpan(ctx, "PlanningService.ConfirmPlan")
+	defer endSpan(span, &err)
+
+	plan, err := s.planRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, models.NotFoundf("plan not found")
+	}
+	if plan.Status == models.PlanStatusConfirmed {
+		return nil, models.ErrPlanAlreadyConfirmed
+	}
+
+	// Either every route of the plan is created and the plan confirmed, or nothing is, so that a
+	// failed confirmation can be retried without duplicating routes
+	err = s.routeService.uow.Do(ctx, func(ctx context.Context) error {
+		routeIDs := make([]primitive.ObjectID, 0, len(plan.Routes))
+		for _, plannedRoute := range plan.Routes {
+			route, err := s.routeService.CreateRoute(ctx, plannedRoute.DriverID, plan.Date, plan.StartLocation)
+			if err != nil {
+				return err
+			}
+			if err := s.routeService.AddPackagesToRoute(ctx, route.ID, plannedRoute.PackageIDs); err != nil {
+				return err
+			}
+			routeIDs = append(routeIDs, route.ID)
+		}
+
+		if err := plan.Confirm(routeIDs); err != nil {
+			return err
+		}
+		return s.planRepo.Update(ctx, plan)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// activeDrivers pages through every active driver
+func (s *PlanningService) activeDrivers(ctx context.Context) ([]*models.Driver, error) {
+	active := true
+	filter := repositories.DriverFilter{Active: &active}
+	page := repositories.PageRequest{Limit: repositories.MaxPageSize}
+
+	var drivers []*models.Driver
+	for {
+		result, err := s.driverRepo.List(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, result.Items...)
+		if result.NextCursor == "" {
+			return drivers, nil
+		}
+		page.Cursor = result.NextCursor
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// createHeavyPackages creates packages that each fill most of a bike, so that a plan needs one bike per package
+func (e *testEnv) createHeavyPackages(t *testing.T, count int) []primitive.ObjectID {
+	t.Helper()
+
+	ids := make([]primitive.ObjectID, count)
+	for i := range ids {
+		location := &models.Location{Latitude: testDepot.Latitude + 0.005*float64(i+1), Longitude: testDepot.Longitude}
+		pkg, err := e.packageService.CreatePackage(context.Background(), primitive.NewObjectID().Hex(), "Customer", "Street 1", "600000000", 15, 0.01, location, nil, 5)
+		if err != nil {
+			t.Fatalf("CreatePackage: %v", err)
+		}
+		ids[i] = pkg.ID
+	}
+	return ids
+}
+
+func TestPlanningService_PreviewPlan(t *testing.T) {
+	tests := []struct {
+		name       string
+		duplicate  bool
+		wantErr    bool
+		wantRoutes int
+	}{
+		{name: "one route per bike", wantRoutes: 2},
+		{name: "package listed twice", duplicate: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.createDriver(t, models.VehicleTypeBike)
+			env.createDriver(t, models.VehicleTypeBike)
+			ids := env.createHeavyPackages(t, 2)
+			if tt.duplicate {
+				ids = append(ids, ids[0])
+			}
+
+			plan, err := env.planningService.PreviewPlan(context.Background(), testDate, testDepot, ids)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PreviewPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, models.ErrValidation) {
+					t.Errorf("PreviewPlan() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if len(plan.Routes) != tt.wantRoutes || len(plan.UnassignedPackageIDs) != 0 {
+				t.Errorf("plan = %+v, want %d routes and every package assigned", plan, tt.wantRoutes)
+			}
+		})
+	}
+}
+
+func TestPlanningService_ConfirmPlan(t *testing.T) {
+	tests := []struct {
+		name                 string
+		deactivateLastDriver bool
+		wantErr              bool
+	}{
+		{name: "draft plan"},
+		{name: "a planned driver left the fleet", deactivateLastDriver: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			env.createDriver(t, models.VehicleTypeBike)
+			env.createDriver(t, models.VehicleTypeBike)
+			plan, err := env.planningService.PreviewPlan(ctx, testDate, testDepot, env.createHeavyPackages(t, 2))
+			if err != nil {
+				t.Fatalf("PreviewPlan: %v", err)
+			}
+			if tt.deactivateLastDriver {
+				driver, err := env.drivers.GetByID(ctx, plan.Routes[len(plan.Routes)-1].DriverID)
+				if err != nil {
+					t.Fatalf("get driver: %v", err)
+				}
+				driver.Active = false
+				if err := env.drivers.Update(ctx, driver); err != nil {
+					t.Fatalf("Update: %v", err)
+				}
+			}
+
+			env.uow.units = 0
+			confirmed, err := env.planningService.ConfirmPlan(ctx, plan.ID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConfirmPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Every route is created within the confirmation's unit, so a failure rolls them all back
+			if env.uow.units != 1 {
+				t.Errorf("ConfirmPlan ran in %d units of work, want 1", env.uow.units)
+			}
+
+			stored, err := env.plans.GetByID(ctx, plan.ID)
+			if err != nil {
+				t.Fatalf("get plan: %v", err)
+			}
+			if tt.wantErr {
+				if stored.Status != models.PlanStatusDraft {
+					t.Errorf("plan status = %s after a failed confirmation, want draft", stored.Status)
+				}
+				return
+			}
+			if stored.Status != models.PlanStatusConfirmed || len(confirmed.RouteIDs) != len(plan.Routes) {
+				t.Errorf("plan = %+v, want it confirmed with %d routes", stored, len(plan.Routes))
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/storage"
+)
+
+// Attachment is a binary file captured at delivery time
+type Attachment struct {
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+// DeliveryProof is the evidence of a delivery together with its signature and photo
+type DeliveryProof struct {
+	RecipientName string           `json:"recipient_name"`
+	Location      *models.Location `json:"location,omitempty"`
+	Signature     *Attachment      `json:"signature,omitempty"`
+	Photo         *Attachment      `json:"photo,omitempty"`
+	CapturedAt    time.Time        `json:"captured_at"`
+}
+
+// Validate checks the proof captured by the driver
+func (p *DeliveryProof) Validate() error {
+	if p.RecipientName == "" {
+		return models.Validationf("invalid proof of delivery: recipient name is required")
+	}
+	if p.Location != nil {
+		if err := p.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliverPackage marks a package as delivered, stores the optional proof of delivery and records
+// the delivery in the package's history
+func deliverPackage(ctx context.Context, packageRepo repositories.PackageRepository, eventRepo repositories.PackageEventRepository, blobs storage.BlobStore, pkg *models.Package, routeID *primitive.ObjectID, proof *DeliveryProof) error {
+	if proof != nil {
+		if err := proof.Validate(); err != nil {
+			return err
+		}
+	}
+
+	from := pkg.CurrentStatus()
+	if err := pkg.MarkAsDelivered(); err != nil {
+		return err
+	}
+
+	var location *models.Location
+	if proof != nil {
+		record, err := storeProofOfDelivery(ctx, blobs, pkg.ID, proof)
+		if err != nil {
+			return err
+		}
+		pkg.ProofOfDelivery = record
+		location = record.Location
+	}
+
+	if err := packageRepo.Update(ctx, pkg); err != nil {
+		return err
+	}
+	return recordStatusChange(ctx, eventRepo, pkg, from, routeID, location, deliveryNote(pkg.ProofOfDelivery))
+}
+
+// storeProofOfDelivery saves the proof's attachments and returns the record to keep on the package
+func storeProofOfDelivery(ctx context.Context, blobs storage.BlobStore, packageID primitive.ObjectID, proof *DeliveryProof) (*models.ProofOfDelivery, error) {
+	capturedAt := time.Now()
+	record := &models.ProofOfDelivery{
+		RecipientName: proof.RecipientName,
+		Location:      proof.Location,
+		CapturedAt:    capturedAt,
+	}
+
+	var err error
+	if record.Signature, err = storeAttachment(ctx, blobs, packageID, "signature", capturedAt, proof.Signature); err != nil {
+		return nil, err
+	}
+	if record.Photo, err = storeAttachment(ctx, blobs, packageID, "photo", capturedAt, proof.Photo); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// storeAttachment saves one attachment under a key unique to the package and capture time
+func storeAttachment(ctx context.Context, blobs storage.BlobStore, packageID primitive.ObjectID, kind string, capturedAt time.Time, attachment *Attachment) (*models.BlobRef, error) {
+	if attachment == nil || len(attachment.Data) == 0 {
+		return nil, nil
+	}
+
+	key := fmt.Sprintf("proof-of-delivery/%s/%d-%s", packageID.Hex(), capturedAt.UnixNano(), kind)
+	if err := blobs.Put(ctx, key, attachment.Data); err != nil {
+		return nil, err
+	}
+	return &models.BlobRef{
+		Key:         key,
+		ContentType: attachment.ContentType,
+		SizeBytes:   len(attachment.Data),
+	}, nil
+}
+
+// loadProofOfDelivery reads a package's proof of delivery back together with its attachments
+func loadProofOfDelivery(ctx context.Context, blobs storage.BlobStore, record *models.ProofOfDelivery) (*DeliveryProof, error) {
+	proof := &DeliveryProof{
+		RecipientName: record.RecipientName,
+		Location:      record.Location,
+		CapturedAt:    record.CapturedAt,
+	}
+
+	var err error
+	if proof.Signature, err = loadAttachment(ctx, blobs, record.Signature); err != nil {
+		return nil, err
+	}
+	if proof.Photo, err = loadAttachment(ctx, blobs, record.Photo); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+func loadAttachment(ctx context.Context, blobs storage.BlobStore, ref *models.BlobRef) (*Attachment, error) {
+	if ref == nil {
+		return nil, nil
+	}
+
+	data, err := blobs.Get(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &Attachment{ContentType: ref.ContentType, Data: data}, nil
+}
+
+// deliveryNote describes a delivery for the package's history
+func deliveryNote(proof *models.ProofOfDelivery) string {
+	if proof == nil {
+		return ""
+	}
+	return "received by " + proof.RecipientName
+}
@@ -3,45 +3,70 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math"
+	"sort"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/storage"
 )
 
 // RouteService handles route business logic
 type RouteService struct {
-	routeRepo   *repositories.RouteRepository
-	driverRepo  *repositories.DriverRepository
-	packageRepo *repositories.PackageRepository
+	routeRepo   repositories.RouteRepository
+	driverRepo  repositories.DriverRepository
+	packageRepo repositories.PackageRepository
+	eventRepo   repositories.PackageEventRepository
+	uow         repositories.UnitOfWork
+	blobs       storage.BlobStore
+	optimizer   optimization.Optimizer
+	capacities  models.VehicleCapacities
+
+	maxDeliveryAttempts int
+	// shiftStart is the time of day drivers set off on routes dated without one
+	shiftStart time.Duration
+}
+
+// RouteOptimization reports the effect of re-sequencing a route's stops
+type RouteOptimization struct {
+	Route            *models.Route
+	DistanceBeforeKm float64
+	DistanceAfterKm  float64
 }
 
 // NewRouteService creates a new route service
-func NewRouteService(routeRepo *repositories.RouteRepository, driverRepo *repositories.DriverRepository, packageRepo *repositories.PackageRepository) *RouteService {
+func NewRouteService(routeRepo repositories.RouteRepository, driverRepo repositories.DriverRepository, packageRepo repositories.PackageRepository, eventRepo repositories.PackageEventRepository, uow repositories.UnitOfWork, blobs storage.BlobStore, optimizer optimization.Optimizer, capacities models.VehicleCapacities, maxDeliveryAttempts int, shiftStart time.Duration) *RouteService {
 	return &RouteService{
 		routeRepo:   routeRepo,
 		driverRepo:  driverRepo,
 		packageRepo: packageRepo,
+		eventRepo:   eventRepo,
+		uow:         uow,
+		blobs:       blobs,
+		optimizer:   optimizer,
+		capacities:  capacities,
+
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		shiftStart:          shiftStart,
 	}
 }
 
 // CreateRoute creates a new route for a driver
-func (s *RouteService) CreateRoute(ctx context.Context, driverID primitive.ObjectID, date time.Time) (*models.Route, error) {
-	// Verify driver exists and is active
-	driver, err := s.driverRepo.GetByID(ctx, driverID)
-	if err != nil {
+func (s *RouteService) CreateRoute(ctx context.Context, driverID primitive.ObjectID, date time.Time, startLocation *models.Location) (_ *models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.CreateRoute")
+	defer endSpan(span, &err)
+
+	if _, err := s.activeDriver(ctx, driverID); err != nil {
 		return nil, err
 	}
-	if driver == nil {
-		return nil, fmt.Errorf("driver not found")
-	}
-	if !driver.Active {
-		return nil, fmt.Errorf("driver is not active")
-	}
 
-	route := models.NewRoute(driverID, date)
+	route := models.NewRoute(driverID, date, startLocation)
 	if err := route.Validate(); err != nil {
 		return nil, err
 	}
@@ -53,153 +78,748 @@ func (s *RouteService) CreateRoute(ctx context.Context, driverID primitive.Objec
 	return route, nil
 }
 
+// activeDriver fetches a driver that can be given routes, failing when it is unknown or inactive
+func (s *RouteService) activeDriver(ctx context.Context, driverID primitive.ObjectID) (*models.Driver, error) {
+	driver, err := s.driverRepo.GetByID(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, models.NotFoundf("driver not found")
+	}
+	if !driver.Active {
+		return nil, models.Conflictf("driver is not active")
+	}
+	return driver, nil
+}
+
 // GetRoute retrieves a route by ID
-func (s *RouteService) GetRoute(ctx context.Context, id primitive.ObjectID) (*models.Route, error) {
+func (s *RouteService) GetRoute(ctx context.Context, id primitive.ObjectID) (_ *models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.GetRoute")
+	defer endSpan(span, &err)
+
 	return s.routeRepo.GetByID(ctx, id)
 }
 
 // GetDriverRoutes retrieves all routes for a driver
-func (s *RouteService) GetDriverRoutes(ctx context.Context, driverID primitive.ObjectID) ([]*models.Route, error) {
+func (s *RouteService) GetDriverRoutes(ctx context.Context, driverID primitive.ObjectID) (_ []*models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.GetDriverRoutes")
+	defer endSpan(span, &err)
+
 	return s.routeRepo.GetByDriverID(ctx, driverID)
 }
 
-// ListRoutes retrieves all routes
-func (s *RouteService) ListRoutes(ctx context.Context) ([]*models.Route, error) {
-	return s.routeRepo.List(ctx)
+// ListRoutes retrieves one page of the routes matching the filter
+func (s *RouteService) ListRoutes(ctx context.Context, filter repositories.RouteFilter, page repositories.PageRequest) (_ *repositories.Page[models.Route], err error) {
+	ctx, span := startSpan(ctx, "RouteService.ListRoutes")
+	defer endSpan(span, &err)
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, models.Validationf("unknown route status %q", filter.Status)
+	}
+	return s.routeRepo.List(ctx, filter, page)
+}
+
+// AddPackagesToRoute adds packages to a route and calculates the route, assigning the packages as a single unit of work
+func (s *RouteService) AddPackagesToRoute(ctx context.Context, routeID primitive.ObjectID, packageIDs []primitive.ObjectID) (err error) {
+	ctx, span := startSpan(ctx, "RouteService.AddPackagesToRoute")
+	defer endSpan(span, &err)
+	span.SetAttributes(attribute.String("route.id", routeID.Hex()), attribute.Int("package.count", len(packageIDs)))
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.addPackagesToRoute(ctx, routeID, packageIDs)
+	})
 }
 
-// AddPackagesToRoute adds packages to a route and calculates the route
-func (s *RouteService) AddPackagesToRoute(ctx context.Context, routeID primitive.ObjectID, packageIDs []primitive.ObjectID) error {
+// addPackagesToRoute applies the change within the caller's unit of work
+func (s *RouteService) addPackagesToRoute(ctx context.Context, routeID primitive.ObjectID, packageIDs []primitive.ObjectID) error {
 	// Get the route
 	route, err := s.routeRepo.GetByID(ctx, routeID)
 	if err != nil {
 		return err
 	}
 	if route == nil {
-		return fmt.Errorf("route not found")
+		return models.NotFoundf("route not found")
 	}
 
 	// Verify route is in planned status
 	if route.Status != models.RouteStatusPending {
-		return fmt.Errorf("can only add packages to pending routes")
+		return models.Conflictf("can only add packages to pending routes")
 	}
 
 	// Get all packages
 	var packages []*models.Package
+	seen := make(map[primitive.ObjectID]bool, len(packageIDs))
 	for _, id := range packageIDs {
+		if seen[id] {
+			return models.Validationf("package %s is listed more than once", id.Hex())
+		}
+		seen[id] = true
+
 		pkg, err := s.packageRepo.GetByID(ctx, id)
 		if err != nil {
 			return err
 		}
 		if pkg == nil {
-			return fmt.Errorf("package %s not found", id.Hex())
+			return models.NotFoundf("package %s not found", id.Hex())
 		}
-		if pkg.Delivered {
-			return fmt.Errorf("package %s is already delivered", id.Hex())
+		if !pkg.CurrentStatus().CanTransitionTo(models.PackageStatusAssigned) {
+			return models.Conflictf("package %s cannot be assigned to a route: it is %s", id.Hex(), pkg.CurrentStatus())
 		}
 		packages = append(packages, pkg)
 	}
 
-	// Calculate optimal route (for now, just add packages in order)
-	// TODO: Implement more sophisticated route optimization
 	for _, pkg := range packages {
 		route.AddPackage(pkg.ID)
 	}
 
-	// Calculate estimated distance and time
-	// TODO: Implement actual distance calculation
-	route.EstimatedDistanceKm = calculateEstimatedDistance(packages)
-	route.EstimatedTimeMin = calculateEstimatedTime(route.EstimatedDistanceKm)
+	// Sequence the stops, calculate estimated distance and time and check the vehicle capacity
+	routePackages, err := s.loadRoutePackages(ctx, route)
+	if err != nil {
+		return err
+	}
+	if err := s.planRoute(ctx, route, routePackages); err != nil {
+		return err
+	}
 
 	// Update the route
-	return s.routeRepo.Update(ctx, route)
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		if err := s.transitionPackage(ctx, route.ID, pkg.ID, models.PackageStatusAssigned); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// UpdateRouteStatus updates a route's status
-func (s *RouteService) UpdateRouteStatus(ctx context.Context, id primitive.ObjectID, status models.RouteStatus) error {
-	route, err := s.routeRepo.GetByID(ctx, id)
+// OptimizeRoute re-sequences the stops of a pending route to reduce its travelled distance
+func (s *RouteService) OptimizeRoute(ctx context.Context, routeID primitive.ObjectID) (_ *RouteOptimization, err error) {
+	ctx, span := startSpan(ctx, "RouteService.OptimizeRoute")
+	defer endSpan(span, &err)
+
+	route, err := s.routeRepo.GetByID(ctx, routeID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if route == nil {
-		return fmt.Errorf("route not found")
+		return nil, models.NotFoundf("route not found")
 	}
 
-	// Validate status transition
-	if route.Status == models.RouteStatusCompleted && status != models.RouteStatusCompleted {
-		return fmt.Errorf("cannot change status of a completed route")
+	if route.Status != models.RouteStatusPending {
+		return nil, models.Conflictf("can only optimize pending routes")
+	}
+
+	packages, err := s.loadRoutePackages(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	distanceBefore := calculateEstimatedDistance(route.StartLocation, stopLocations(route, packages))
+
+	if err := s.planRoute(ctx, route, packages); err != nil {
+		return nil, err
 	}
 
-	// Update route status
-	if err := route.UpdateStatus(status); err != nil {
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return nil, err
+	}
+
+	return &RouteOptimization{
+		Route:            route,
+		DistanceBeforeKm: distanceBefore,
+		DistanceAfterKm:  route.EstimatedDistanceKm,
+	}, nil
+}
+
+// UpdateRouteStatus moves a route through its lifecycle and updates its packages accordingly, as a single unit of work
+func (s *RouteService) UpdateRouteStatus(ctx context.Context, id primitive.ObjectID, status models.RouteStatus) (err error) {
+	ctx, span := startSpan(ctx, "RouteService.UpdateRouteStatus")
+	defer endSpan(span, &err)
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.updateRouteStatus(ctx, id, status)
+	})
+}
+
+// updateRouteStatus applies the change within the caller's unit of work
+func (s *RouteService) updateRouteStatus(ctx context.Context, id primitive.ObjectID, status models.RouteStatus) error {
+	if !status.IsValid() {
+		return models.Validationf("unknown route status %q", status)
+	}
+
+	route, err := s.routeRepo.GetByID(ctx, id)
+	if err != nil {
 		return err
 	}
+	if route == nil {
+		return models.NotFoundf("route not found")
+	}
 
-	// Update package statuses based on route status
-	if status == models.RouteStatusActive {
+	previous := route.Status
+	if err := route.TransitionTo(status); err != nil {
+		return err
+	}
+
+	switch {
+	case status == models.RouteStatusActive && previous == models.RouteStatusPending:
+		// Packages leave the depot when the route starts
 		for _, pkg := range route.Packages {
-			if err := s.packageRepo.UpdateStatus(ctx, pkg.PackageID, models.PackageStatusAssigned); err != nil {
+			if err := s.transitionPackage(ctx, route.ID, pkg.PackageID, models.PackageStatusOutForDelivery); err != nil {
 				return err
 			}
 		}
-	} else if status == models.RouteStatusCompleted {
-		for _, pkg := range route.Packages {
-			if err := s.packageRepo.UpdateStatus(ctx, pkg.PackageID, models.PackageStatusDelivered); err != nil {
+	case status == models.RouteStatusCancelled:
+		// Release the packages of the stops still outstanding back to the pending pool; a package
+		// re-queued after a failed stop may since have been assigned to another route
+		for _, stop := range route.Packages {
+			if stop.Delivered || stop.Failed {
+				continue
+			}
+			pkg, err := s.packageRepo.GetByID(ctx, stop.PackageID)
+			if err != nil {
+				return err
+			}
+			if !pkg.CurrentStatus().CanTransitionTo(models.PackageStatusPending) {
+				continue
+			}
+			assignedTo, err := s.assignedRouteID(ctx, pkg.ID)
+			if err != nil {
+				return err
+			}
+			if assignedTo == nil || *assignedTo != route.ID {
+				continue
+			}
+			if err := s.applyPackageTransition(ctx, route.ID, pkg, models.PackageStatusPending); err != nil {
 				return err
 			}
 		}
 	}
 
-	return s.routeRepo.UpdateStatus(ctx, id, status)
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "route status changed",
+		slog.String("route_id", id.Hex()),
+		slog.String("from", string(previous)),
+		slog.String("to", string(status)),
+		slog.String("actor", ActorFromContext(ctx)),
+	)
+
+	// Start projecting arrivals as soon as the driver sets off
+	if status == models.RouteStatusActive {
+		_, err := s.refreshProjections(ctx, id)
+		return err
+	}
+	return nil
 }
 
-// UpdatePackageDeliveryStatus updates a package's delivery status in a route
-func (s *RouteService) UpdatePackageDeliveryStatus(ctx context.Context, routeID, packageID primitive.ObjectID, delivered bool) error {
+// UpdatePackageDeliveryStatus updates a package's delivery status in a route and in the package itself
+// as a single unit of work, returning the updated route; proof of delivery is only kept when the
+// package is delivered
+func (s *RouteService) UpdatePackageDeliveryStatus(ctx context.Context, routeID, packageID primitive.ObjectID, delivered bool, proof *DeliveryProof) (_ *models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.UpdatePackageDeliveryStatus")
+	defer endSpan(span, &err)
+
+	var route *models.Route
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		route, err = s.updatePackageDeliveryStatus(ctx, routeID, packageID, delivered, proof)
+		return err
+	})
+	return route, err
+}
+
+// updatePackageDeliveryStatus applies the change within the caller's unit of work
+func (s *RouteService) updatePackageDeliveryStatus(ctx context.Context, routeID, packageID primitive.ObjectID, delivered bool, proof *DeliveryProof) (*models.Route, error) {
 	route, err := s.routeRepo.GetByID(ctx, routeID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if route == nil {
-		return fmt.Errorf("route not found")
+		return nil, models.NotFoundf("route not found")
 	}
 
 	// Verify route is in progress
 	if route.Status != models.RouteStatusActive {
-		return fmt.Errorf("can only update package status for routes in progress")
+		return nil, models.Conflictf("can only update package status for routes in progress")
 	}
 
-	// Update package status in route
-	if err := s.routeRepo.UpdatePackageStatus(ctx, routeID, packageID, delivered); err != nil {
-		return err
+	// Update package status in route, rejecting a stop already in the requested state before any write
+	if err := route.UpdatePackageStatus(packageID, delivered); err != nil {
+		return nil, err
+	}
+	if err := s.routeRepo.UpdatePackageStatus(ctx, route, packageID); err != nil {
+		return nil, err
 	}
 
 	// Update package status
-	status := models.PackageStatusAssigned
+	pkg, err := s.packageRepo.GetByID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
 	if delivered {
-		status = models.PackageStatusDelivered
+		err = deliverPackage(ctx, s.packageRepo, s.eventRepo, s.blobs, pkg, &routeID, proof)
+	} else {
+		err = s.applyPackageTransition(ctx, routeID, pkg, models.PackageStatusOutForDelivery)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Recalculate the projected arrivals of the remaining stops
+	return s.refreshProjections(ctx, routeID)
+}
+
+// DeliverPackage marks a package delivered at its stop on the route it is assigned to, keeping the
+// proof of delivery when one is given, as a single unit of work
+func (s *RouteService) DeliverPackage(ctx context.Context, packageID primitive.ObjectID, proof *DeliveryProof) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "RouteService.DeliverPackage")
+	defer endSpan(span, &err)
+
+	var pkg *models.Package
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.packageRepo.GetByID(ctx, packageID); err != nil {
+			return err
+		}
+		routeID, err := s.assignedRouteID(ctx, packageID)
+		if err != nil {
+			return err
+		}
+		if routeID == nil {
+			return models.Conflictf("package %s is not on a route", packageID.Hex())
+		}
+
+		if _, err := s.updatePackageDeliveryStatus(ctx, *routeID, packageID, true, proof); err != nil {
+			return err
+		}
+		pkg, err = s.packageRepo.GetByID(ctx, packageID)
+		return err
+	})
+	return pkg, err
+}
+
+// RecordFailedDeliveryAttempt records a failed attempt at a route stop, then sends the package back
+// to the pending pool or, once it has used up its attempts, returns it to the sender, as a single unit of work
+func (s *RouteService) RecordFailedDeliveryAttempt(ctx context.Context, routeID, packageID primitive.ObjectID, reason models.FailureReason, note string) (_ *models.Package, err error) {
+	ctx, span := startSpan(ctx, "RouteService.RecordFailedDeliveryAttempt")
+	defer endSpan(span, &err)
+
+	var pkg *models.Package
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pkg, err = s.recordFailedDeliveryAttempt(ctx, routeID, packageID, reason, note)
+		return err
+	})
+	return pkg, err
+}
+
+// recordFailedDeliveryAttempt records the failed attempt within the caller's unit of work
+func (s *RouteService) recordFailedDeliveryAttempt(ctx context.Context, routeID, packageID primitive.ObjectID, reason models.FailureReason, note string) (*models.Package, error) {
+	route, err := s.routeRepo.GetByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route == nil {
+		return nil, models.NotFoundf("route not found")
+	}
+	if route.Status != models.RouteStatusActive {
+		return nil, models.Conflictf("can only record delivery attempts for routes in progress")
+	}
+
+	pkg, err := s.packageRepo.GetByID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := route.MarkStopFailed(packageID, reason); err != nil {
+		return nil, err
+	}
+
+	from := pkg.CurrentStatus()
+	if err := pkg.RecordFailedAttempt(reason, note); err != nil {
+		return nil, err
+	}
+	if err := s.packageRepo.Update(ctx, pkg); err != nil {
+		return nil, err
+	}
+	attemptNote := fmt.Sprintf("attempt %d: %s", pkg.FailedAttempts, reason)
+	if note != "" {
+		attemptNote += " - " + note
+	}
+	if err := recordStatusChange(ctx, s.eventRepo, pkg, from, &routeID, nil, attemptNote); err != nil {
+		return nil, err
+	}
+
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return nil, err
+	}
+
+	// Re-queue the package for planning or give up on it
+	if err := s.applyPackageTransition(ctx, routeID, pkg, pkg.StatusAfterFailure(s.maxDeliveryAttempts)); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.refreshProjections(ctx, routeID); err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+// GetRouteETA retrieves a route with the projected arrival of its remaining stops recalculated as of now
+func (s *RouteService) GetRouteETA(ctx context.Context, routeID primitive.ObjectID) (_ *models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.GetRouteETA")
+	defer endSpan(span, &err)
+
+	route, err := s.routeRepo.GetByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route == nil {
+		return nil, models.NotFoundf("route not found")
+	}
+
+	packages, err := s.loadRoutePackages(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := s.driverRepo.GetByID(ctx, route.DriverID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(route.Packages, func(i, j int) bool {
+		return route.Packages[i].OrderInRoute < route.Packages[j].OrderInRoute
+	})
+	projectArrivals(route, packages, driver.VehicleType.SpeedProfile(), s.departure(route.Date), time.Now())
+
+	return route, nil
+}
+
+// refreshProjections recalculates and stores the projected arrivals of a route, returning the stored route
+func (s *RouteService) refreshProjections(ctx context.Context, routeID primitive.ObjectID) (*models.Route, error) {
+	route, err := s.GetRouteETA(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+// transitionPackage loads a package and moves it through its lifecycle to the given status
+func (s *RouteService) transitionPackage(ctx context.Context, routeID, id primitive.ObjectID, status models.PackageStatus) error {
+	pkg, err := s.packageRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.applyPackageTransition(ctx, routeID, pkg, status)
+}
+
+// applyPackageTransition moves a loaded package to the given status and records it in the package's history
+func (s *RouteService) applyPackageTransition(ctx context.Context, routeID primitive.ObjectID, pkg *models.Package, status models.PackageStatus) error {
+	from := pkg.CurrentStatus()
+	if err := pkg.TransitionTo(status); err != nil {
+		return err
+	}
+	if err := s.packageRepo.Update(ctx, pkg); err != nil {
+		return err
+	}
+	return recordStatusChange(ctx, s.eventRepo, pkg, from, &routeID, nil, "")
+}
+
+// assignedRouteID returns the route a package was last assigned to according to its history, or
+// nil when it never was
+func (s *RouteService) assignedRouteID(ctx context.Context, packageID primitive.ObjectID) (*primitive.ObjectID, error) {
+	events, err := s.eventRepo.ListByPackageID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == models.PackageEventAssigned {
+			return events[i].RouteID, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadRoutePackages fetches every package on the route, keyed by ID
+func (s *RouteService) loadRoutePackages(ctx context.Context, route *models.Route) (map[primitive.ObjectID]*models.Package, error) {
+	packages := make(map[primitive.ObjectID]*models.Package, len(route.Packages))
+	for _, stop := range route.Packages {
+		pkg, err := s.packageRepo.GetByID(ctx, stop.PackageID)
+		if err != nil {
+			return nil, err
+		}
+		packages[stop.PackageID] = pkg
+	}
+	return packages, nil
+}
+
+// planRoute sequences the route's stops for its driver and recalculates its estimates and load
+func (s *RouteService) planRoute(ctx context.Context, route *models.Route, packages map[primitive.ObjectID]*models.Package) error {
+	driver, err := s.driverRepo.GetByID(ctx, route.DriverID)
+	if err != nil {
+		return err
+	}
+
+	s.sequenceStops(route, driver, packages)
+	return s.updateEstimates(route, driver, packages)
+}
+
+// sequenceStops reorders the route's stops with the optimizer; stops without coordinates are visited last
+func (s *RouteService) sequenceStops(route *models.Route, driver *models.Driver, packages map[primitive.ObjectID]*models.Package) {
+	var stops []optimization.Stop
+	for _, stop := range orderedStops(route) {
+		if pkg := packages[stop.PackageID]; pkg != nil && pkg.Location != nil {
+			stops = append(stops, optimization.Stop{
+				ID:              pkg.ID,
+				Location:        *pkg.Location,
+				Window:          pkg.DeliveryWindow,
+				ServiceDuration: pkg.ServiceDuration(),
+			})
+		}
+	}
+
+	problem := optimization.NewProblem(route.StartLocation, stops)
+	problem.Departure = s.departure(route.Date)
+	problem.SpeedKmh = driver.VehicleType.SpeedProfile().AverageSpeedKmh
+
+	tour := s.optimizer.Optimize(problem)
+
+	packageIDs := make([]primitive.ObjectID, len(tour))
+	for i, index := range tour {
+		packageIDs[i] = stops[index].ID
+	}
+	route.Resequence(packageIDs)
+}
+
+// updateEstimates recalculates the estimated distance, stop arrivals and duration of a route
+// and its load against the driver's vehicle capacity
+func (s *RouteService) updateEstimates(route *models.Route, driver *models.Driver, packages map[primitive.ObjectID]*models.Package) error {
+	sort.SliceStable(route.Packages, func(i, j int) bool {
+		return route.Packages[i].OrderInRoute < route.Packages[j].OrderInRoute
+	})
+
+	route.EstimatedDistanceKm = calculateEstimatedDistance(route.StartLocation, stopLocations(route, packages))
+	route.EstimatedTimeMin = scheduleStops(route, packages, driver.VehicleType.SpeedProfile(), s.departure(route.Date))
+
+	var weightKg, volumeM3 float64
+	for _, pkg := range packages {
+		weightKg += pkg.WeightKg
+		volumeM3 += pkg.VolumeM3
 	}
-	return s.packageRepo.UpdateStatus(ctx, packageID, status)
+	return route.UpdateLoad(weightKg, volumeM3, s.capacities.ForDriver(driver))
 }
 
 // Helper functions for route calculations
-func calculateEstimatedDistance(packages []*models.Package) float64 {
-	// TODO: Implement actual distance calculation using coordinates
-	// For now, return a mock value
-	return float64(len(packages)) * 5.0 // 5km per package
+func calculateEstimatedDistance(start *models.Location, stops []*models.Location) float64 {
+	// Sum the legs between consecutive known locations; stops without
+	// coordinates are skipped rather than guessed
+	var distance float64
+	previous := start
+	for _, location := range stops {
+		if location == nil {
+			continue
+		}
+		if previous != nil {
+			distance += previous.DistanceKm(*location)
+		}
+		previous = location
+	}
+	return distance
+}
+
+// departure returns when the driver sets off on a route of the given date: at the shift start when
+// the date has no time of day, otherwise at the date itself
+func (s *RouteService) departure(date time.Time) time.Time {
+	year, month, day := date.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, date.Location())
+	if date.Equal(midnight) {
+		return midnight.Add(s.shiftStart)
+	}
+	return date
+}
+
+// scheduleStops projects the arrival at each stop when leaving at departure, waiting for delivery
+// windows to open and spending each package's service duration at its stop. Stops reached after
+// their window closes are flagged. It returns the total route duration in minutes.
+func scheduleStops(route *models.Route, packages map[primitive.ObjectID]*models.Package, profile models.SpeedProfile, departure time.Time) int {
+	clock := departure
+	previous := route.StartLocation
+	for i := range route.Packages {
+		stop := &route.Packages[i]
+		stop.PlannedArrival = nil
+		stop.OutsideTimeWindow = false
+
+		pkg := packages[stop.PackageID]
+		if pkg == nil || pkg.Location == nil {
+			continue
+		}
+
+		clock = clock.Add(legDuration(previous, pkg.Location, profile))
+		arrival := clock
+		stop.PlannedArrival = &arrival
+
+		if window := pkg.DeliveryWindow; window != nil {
+			if clock.Before(window.Earliest) {
+				clock = window.Earliest
+			} else if clock.After(window.Latest) {
+				stop.OutsideTimeWindow = true
+			}
+		}
+
+		clock = clock.Add(pkg.ServiceDuration())
+		previous = pkg.Location
+	}
+
+	return int(math.Round(clock.Sub(departure).Minutes()))
+}
+
+// projectArrivals refreshes the projected arrival at every stop still to be delivered and flags
+// the stops expected outside their delivery window. On an active route the projection starts at
+// the most recent delivery, or at departure when nothing has been delivered yet, and is never
+// earlier than now. Pending routes simply mirror their plan.
+func projectArrivals(route *models.Route, packages map[primitive.ObjectID]*models.Package, profile models.SpeedProfile, departure, now time.Time) {
+	if route.Status != models.RouteStatusActive {
+		for i := range route.Packages {
+			stop := &route.Packages[i]
+			stop.ProjectedArrival = nil
+			if route.Status == models.RouteStatusPending && !stop.Delivered && !stop.Failed {
+				stop.ProjectedArrival = stop.PlannedArrival
+			}
+		}
+		return
+	}
+
+	clock := departure
+	previous := route.StartLocation
+	var lastDelivery *time.Time
+	for _, stop := range route.Packages {
+		if stop.Delivered && stop.DeliveryTimestamp != nil && (lastDelivery == nil || stop.DeliveryTimestamp.After(*lastDelivery)) {
+			lastDelivery = stop.DeliveryTimestamp
+			clock = *stop.DeliveryTimestamp
+			previous = nil
+			if pkg := packages[stop.PackageID]; pkg != nil {
+				previous = pkg.Location
+			}
+		}
+	}
+
+	for i := range route.Packages {
+		stop := &route.Packages[i]
+		pkg := packages[stop.PackageID]
+		stop.ProjectedArrival = nil
+
+		if stop.Delivered {
+			stop.OutsideTimeWindow = pkg != nil && pkg.DeliveryWindow != nil && stop.DeliveryTimestamp != nil &&
+				!pkg.DeliveryWindow.Contains(*stop.DeliveryTimestamp)
+			continue
+		}
+		if stop.Failed || pkg == nil || pkg.Location == nil {
+			continue
+		}
+
+		clock = clock.Add(legDuration(previous, pkg.Location, profile))
+		if clock.Before(now) {
+			clock = now
+		}
+		arrival := clock
+		stop.ProjectedArrival = &arrival
+		stop.OutsideTimeWindow = false
+
+		if window := pkg.DeliveryWindow; window != nil {
+			if clock.Before(window.Earliest) {
+				clock = window.Earliest
+			} else if clock.After(window.Latest) {
+				stop.OutsideTimeWindow = true
+			}
+		}
+
+		clock = clock.Add(pkg.ServiceDuration())
+		previous = pkg.Location
+	}
 }
 
-func calculateEstimatedTime(distanceKm float64) int {
-	// TODO: Implement actual time calculation
-	// For now, assume average speed of 50 km/h
-	return int(distanceKm / 50.0 * 60.0) // Convert to minutes
+// legDuration returns the time needed to drive between two locations, zero when either is unknown
+func legDuration(from, to *models.Location, profile models.SpeedProfile) time.Duration {
+	if from == nil || to == nil {
+		return 0
+	}
+	travelMin := profile.TravelTimeMin(from.DistanceKm(*to))
+	return time.Duration(travelMin * float64(time.Minute))
 }
 
-// UpdateRoute updates an existing route
-func (s *RouteService) UpdateRoute(ctx context.Context, route *models.Route) error {
-	return s.routeRepo.Update(ctx, route)
+// orderedStops returns a copy of the route's stops sorted by their position in the route
+func orderedStops(route *models.Route) []models.PackageRoute {
+	stops := make([]models.PackageRoute, len(route.Packages))
+	copy(stops, route.Packages)
+	sort.Slice(stops, func(i, j int) bool {
+		return stops[i].OrderInRoute < stops[j].OrderInRoute
+	})
+	return stops
+}
+
+// stopLocations returns the location of each stop in route order, nil where it is unknown
+func stopLocations(route *models.Route, packages map[primitive.ObjectID]*models.Package) []*models.Location {
+	stops := orderedStops(route)
+	locations := make([]*models.Location, len(stops))
+	for i, stop := range stops {
+		if pkg := packages[stop.PackageID]; pkg != nil {
+			locations[i] = pkg.Location
+		}
+	}
+	return locations
+}
+
+// UpdateRoute reassigns a route to an active driver and date, recalculating its estimates and load
+// for them; a non-zero version makes it fail when the route changed since that version
+func (s *RouteService) UpdateRoute(ctx context.Context, id primitive.ObjectID, version int64, driverID primitive.ObjectID, date time.Time) (_ *models.Route, err error) {
+	ctx, span := startSpan(ctx, "RouteService.UpdateRoute")
+	defer endSpan(span, &err)
+
+	if date.IsZero() {
+		return nil, models.Validationf("route date is required")
+	}
+
+	route, err := s.routeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 {
+		route.Version = version
+	}
+
+	driver, err := s.activeDriver(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	packages, err := s.loadRoutePackages(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	route.DriverID = driverID
+	route.Date = date
+	if err := s.updateEstimates(route, driver, packages); err != nil {
+		return nil, err
+	}
+
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return nil, err
+	}
+
+	return route, nil
 }
 
 // DeleteRoute deletes a route by ID
-func (s *RouteService) DeleteRoute(ctx context.Context, id primitive.ObjectID) error {
+func (s *RouteService) DeleteRoute(ctx context.Context, id primitive.ObjectID) (err error) {
+	ctx, span := startSpan(ctx, "RouteService.DeleteRoute")
+	defer endSpan(span, &err)
+
 	return s.routeRepo.Delete(ctx, id)
 }
@@ -0,0 +1,867 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func TestRouteService_CreateRoute(t *testing.T) {
+	tests := []struct {
+		name          string
+		inactive      bool
+		unknown       bool
+		startLocation *models.Location
+		wantErr       bool
+	}{
+		{name: "active driver", startLocation: testDepot},
+		{name: "without start location"},
+		{name: "inactive driver", inactive: true, wantErr: true},
+		{name: "unknown driver", unknown: true, wantErr: true},
+		{name: "invalid start location", startLocation: &models.Location{Latitude: -91}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			driver := env.createDriver(t, models.VehicleTypeVan)
+			if tt.inactive {
+				if _, err := env.driverService.UpdateDriver(ctx, driver.ID, 0, driver.Name, driver.VehicleType, false, nil); err != nil {
+					t.Fatalf("UpdateDriver: %v", err)
+				}
+			}
+			driverID := driver.ID
+			if tt.unknown {
+				driverID = primitive.NewObjectID()
+			}
+
+			route, err := env.routeService.CreateRoute(ctx, driverID, testDate, tt.startLocation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateRoute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if route.ID.IsZero() || route.Status != models.RouteStatusPending || len(route.Packages) != 0 {
+				t.Errorf("CreateRoute() = %+v, want an empty pending route", route)
+			}
+		})
+	}
+}
+
+func TestRouteService_GetRoute(t *testing.T) {
+	env := newTestEnv(t)
+	route, _ := env.createRoute(t, 1)
+
+	tests := []struct {
+		name    string
+		id      primitive.ObjectID
+		wantErr bool
+	}{
+		{name: "existing route", id: route.ID},
+		{name: "unknown route", id: primitive.NewObjectID(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := env.routeService.GetRoute(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRoute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(got.Packages) != 1 {
+				t.Errorf("GetRoute() has %d packages, want 1", len(got.Packages))
+			}
+		})
+	}
+}
+
+func TestRouteService_GetDriverRoutes(t *testing.T) {
+	env := newTestEnv(t)
+	route, _ := env.createRoute(t, 0)
+	idle := env.createDriver(t, models.VehicleTypeBike)
+
+	tests := []struct {
+		name     string
+		driverID primitive.ObjectID
+		want     int
+	}{
+		{name: "driver with a route", driverID: route.DriverID, want: 1},
+		{name: "driver without routes", driverID: idle.ID, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes, err := env.routeService.GetDriverRoutes(context.Background(), tt.driverID)
+			if err != nil {
+				t.Fatalf("GetDriverRoutes() error = %v", err)
+			}
+			if len(routes) != tt.want {
+				t.Errorf("GetDriverRoutes() returned %d routes, want %d", len(routes), tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteService_ListRoutes(t *testing.T) {
+	delivered, undelivered := true, false
+
+	tests := []struct {
+		name      string
+		filter    func(driverID primitive.ObjectID) repositories.RouteFilter
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "no filter", wantCount: 4},
+		{name: "by status", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{Status: models.RouteStatusActive}
+		}, wantCount: 1},
+		{name: "by driver", filter: func(driverID primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{DriverID: driverID}
+		}, wantCount: 1},
+		{name: "on the delivery day", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{DateFrom: testDate, DateTo: testDate.Add(time.Hour)}
+		}, wantCount: 4},
+		{name: "after the delivery day", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{DateFrom: testDate.AddDate(0, 0, 1)}
+		}, wantCount: 0},
+		{name: "fully delivered", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{Delivered: &delivered}
+		}, wantCount: 2},
+		{name: "with stops to deliver", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{Delivered: &undelivered}
+		}, wantCount: 1},
+		{name: "unknown status", filter: func(primitive.ObjectID) repositories.RouteFilter {
+			return repositories.RouteFilter{Status: "lost"}
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			// An empty route, an active route with a stop left, a completed route fully delivered and a
+			// completed route with a delivered and a failed stop
+			empty, _ := env.createRoute(t, 0)
+			env.createActiveRoute(t, 1)
+			done, packages := env.createActiveRoute(t, 1)
+			if _, err := env.routeService.UpdatePackageDeliveryStatus(ctx, done.ID, packages[0].ID, true, nil); err != nil {
+				t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+			}
+			if err := env.routeService.UpdateRouteStatus(ctx, done.ID, models.RouteStatusCompleted); err != nil {
+				t.Fatalf("UpdateRouteStatus: %v", err)
+			}
+			attempted, packages := env.createActiveRoute(t, 2)
+			if _, err := env.routeService.UpdatePackageDeliveryStatus(ctx, attempted.ID, packages[0].ID, true, nil); err != nil {
+				t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+			}
+			if _, err := env.routeService.RecordFailedDeliveryAttempt(ctx, attempted.ID, packages[1].ID, models.FailureReasonRefused, ""); err != nil {
+				t.Fatalf("RecordFailedDeliveryAttempt: %v", err)
+			}
+			if err := env.routeService.UpdateRouteStatus(ctx, attempted.ID, models.RouteStatusCompleted); err != nil {
+				t.Fatalf("UpdateRouteStatus: %v", err)
+			}
+
+			var filter repositories.RouteFilter
+			if tt.filter != nil {
+				filter = tt.filter(empty.DriverID)
+			}
+			page, err := env.routeService.ListRoutes(ctx, filter, repositories.PageRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(page.Items) != tt.wantCount {
+				t.Errorf("ListRoutes() returned %d routes, want %d", len(page.Items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestRouteService_AddPackagesToRoute(t *testing.T) {
+	tests := []struct {
+		name        string
+		routeStatus models.RouteStatus
+		packageSize float64
+		assigned    bool
+		unknown     bool
+		repeated    bool
+		wantErr     bool
+		wantErrIs   error
+	}{
+		{name: "pending packages on a pending route", packageSize: 1},
+		{name: "package listed twice", packageSize: 1, repeated: true, wantErr: true, wantErrIs: models.ErrValidation},
+		{name: "route already started", routeStatus: models.RouteStatusActive, packageSize: 1, wantErr: true},
+		{name: "package already assigned", packageSize: 1, assigned: true, wantErr: true},
+		{name: "unknown package", packageSize: 1, unknown: true, wantErr: true},
+		{name: "load exceeds the vehicle", packageSize: 1000, wantErr: true, wantErrIs: models.ErrCapacityExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			route, _ := env.createRoute(t, 0)
+			if tt.routeStatus != "" {
+				route.Status = tt.routeStatus
+				if err := env.routes.Update(ctx, route); err != nil {
+					t.Fatalf("Update: %v", err)
+				}
+			}
+
+			var ids []primitive.ObjectID
+			for i := 0; i < 2; i++ {
+				location := &models.Location{Latitude: testDepot.Latitude + 0.01*float64(i+1), Longitude: testDepot.Longitude}
+				pkg, err := env.packageService.CreatePackage(ctx, primitive.NewObjectID().Hex(), "Customer", "Street", "600000000", tt.packageSize, tt.packageSize/100, location, nil, 0)
+				if err != nil {
+					t.Fatalf("CreatePackage: %v", err)
+				}
+				ids = append(ids, pkg.ID)
+			}
+			if tt.assigned {
+				other, _ := env.createRoute(t, 0)
+				if err := env.routeService.AddPackagesToRoute(ctx, other.ID, ids[:1]); err != nil {
+					t.Fatalf("AddPackagesToRoute: %v", err)
+				}
+			}
+			if tt.unknown {
+				ids = append(ids, primitive.NewObjectID())
+			}
+			if tt.repeated {
+				ids = append(ids, ids[0])
+			}
+
+			err := env.routeService.AddPackagesToRoute(ctx, route.ID, ids)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddPackagesToRoute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Fatalf("AddPackagesToRoute() error = %v, want %v", err, tt.wantErrIs)
+			}
+
+			stored := env.getRoute(t, route.ID)
+			if tt.wantErr {
+				if len(stored.Packages) != 0 {
+					t.Errorf("route has %d packages after a rejected add, want 0", len(stored.Packages))
+				}
+				if tt.repeated {
+					if status := env.getPackage(t, ids[0]).Status; status != models.PackageStatusPending {
+						t.Errorf("package status = %s after a rejected add, want pending", status)
+					}
+				}
+				return
+			}
+			if len(stored.Packages) != len(ids) || stored.EstimatedDistanceKm <= 0 || stored.LoadWeightKg != 2*tt.packageSize {
+				t.Errorf("route = %+v, want both packages with estimates and load", stored)
+			}
+			for _, id := range ids {
+				if status := env.getPackage(t, id).Status; status != models.PackageStatusAssigned {
+					t.Errorf("package %s status = %s, want assigned", id.Hex(), status)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteService_ScheduleFromShiftStart(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		date          time.Time
+		wantDeparture time.Time
+	}{
+		{name: "date without a time of day", date: day, wantDeparture: day.Add(testShiftStart)},
+		{name: "date with a time of day", date: day.Add(13 * time.Hour), wantDeparture: day.Add(13 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			driver := env.createDriver(t, models.VehicleTypeVan)
+			route, err := env.routeService.CreateRoute(ctx, driver.ID, tt.date, testDepot)
+			if err != nil {
+				t.Fatalf("CreateRoute: %v", err)
+			}
+			pkg := env.createPackage(t, "TRK-SHIFT", 0.01)
+			if err := env.routeService.AddPackagesToRoute(ctx, route.ID, []primitive.ObjectID{pkg.ID}); err != nil {
+				t.Fatalf("AddPackagesToRoute: %v", err)
+			}
+
+			// The stop is a few minutes' drive from the depot
+			arrival := env.getRoute(t, route.ID).Stop(pkg.ID).PlannedArrival
+			if arrival == nil || !arrival.After(tt.wantDeparture) || arrival.After(tt.wantDeparture.Add(time.Hour)) {
+				t.Errorf("planned arrival = %v, want shortly after departing at %v", arrival, tt.wantDeparture)
+			}
+		})
+	}
+}
+
+func TestRouteService_OptimizeRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   bool
+		wantErr bool
+	}{
+		{name: "pending route"},
+		{name: "active route", start: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var route *models.Route
+			if tt.start {
+				route, _ = env.createActiveRoute(t, 3)
+			} else {
+				route, _ = env.createRoute(t, 3)
+			}
+
+			result, err := env.routeService.OptimizeRoute(ctx, route.ID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OptimizeRoute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if result.DistanceAfterKm > result.DistanceBeforeKm+1e-9 {
+				t.Errorf("OptimizeRoute() distance went from %.3f to %.3f km", result.DistanceBeforeKm, result.DistanceAfterKm)
+			}
+			for i, stop := range result.Route.Packages {
+				if stop.OrderInRoute != i+1 {
+					t.Errorf("stop %d has order %d", i, stop.OrderInRoute)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteService_UpdateRouteStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         models.RouteStatus
+		deliverAll   bool
+		to           models.RouteStatus
+		wantErr      bool
+		wantErrIs    error
+		wantPackages models.PackageStatus
+	}{
+		{name: "start a pending route", from: models.RouteStatusPending, to: models.RouteStatusActive, wantPackages: models.PackageStatusOutForDelivery},
+		{name: "cancel a pending route", from: models.RouteStatusPending, to: models.RouteStatusCancelled, wantPackages: models.PackageStatusPending},
+		{name: "cancel an active route", from: models.RouteStatusActive, to: models.RouteStatusCancelled, wantPackages: models.PackageStatusPending},
+		{name: "suspend an active route", from: models.RouteStatusActive, to: models.RouteStatusSuspended, wantPackages: models.PackageStatusOutForDelivery},
+		{name: "complete a delivered route", from: models.RouteStatusActive, deliverAll: true, to: models.RouteStatusCompleted, wantPackages: models.PackageStatusDelivered},
+		{name: "complete with pending packages", from: models.RouteStatusActive, to: models.RouteStatusCompleted, wantErr: true, wantErrIs: models.ErrRouteHasPendingPackages},
+		{name: "complete a pending route", from: models.RouteStatusPending, to: models.RouteStatusCompleted, wantErr: true, wantErrIs: models.ErrInvalidTransition},
+		{name: "unknown status", from: models.RouteStatusPending, to: "paused", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var route *models.Route
+			var packages []*models.Package
+			if tt.from == models.RouteStatusActive {
+				route, packages = env.createActiveRoute(t, 2)
+			} else {
+				route, packages = env.createRoute(t, 2)
+			}
+			if tt.deliverAll {
+				for _, pkg := range packages {
+					if _, err := env.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, pkg.ID, true, nil); err != nil {
+						t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+					}
+				}
+			}
+
+			err := env.routeService.UpdateRouteStatus(ctx, route.ID, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateRouteStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Fatalf("UpdateRouteStatus() error = %v, want %v", err, tt.wantErrIs)
+			}
+
+			stored := env.getRoute(t, route.ID)
+			if tt.wantErr {
+				if stored.Status != tt.from {
+					t.Errorf("route status = %s, want it unchanged at %s", stored.Status, tt.from)
+				}
+				return
+			}
+			if stored.Status != tt.to {
+				t.Errorf("route status = %s, want %s", stored.Status, tt.to)
+			}
+			for _, pkg := range packages {
+				if status := env.getPackage(t, pkg.ID).Status; status != tt.wantPackages {
+					t.Errorf("package status = %s, want %s", status, tt.wantPackages)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteService_UpdateRouteStatus_CancelAfterReplanning(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	// The first package fails on route A and is re-planned onto route B
+	routeA, packages := env.createActiveRoute(t, 2)
+	replanned, outstanding := packages[0], packages[1]
+	if _, err := env.routeService.RecordFailedDeliveryAttempt(ctx, routeA.ID, replanned.ID, models.FailureReasonCustomerNotHome, ""); err != nil {
+		t.Fatalf("RecordFailedDeliveryAttempt: %v", err)
+	}
+	routeB, _ := env.createRoute(t, 0)
+	if err := env.routeService.AddPackagesToRoute(ctx, routeB.ID, []primitive.ObjectID{replanned.ID}); err != nil {
+		t.Fatalf("AddPackagesToRoute: %v", err)
+	}
+
+	if err := env.routeService.UpdateRouteStatus(ctx, routeA.ID, models.RouteStatusCancelled); err != nil {
+		t.Fatalf("UpdateRouteStatus: %v", err)
+	}
+
+	if status := env.getPackage(t, replanned.ID).Status; status != models.PackageStatusAssigned {
+		t.Errorf("re-planned package status = %s, want it kept assigned to route B", status)
+	}
+	if status := env.getPackage(t, outstanding.ID).Status; status != models.PackageStatusPending {
+		t.Errorf("outstanding package status = %s, want pending", status)
+	}
+	if err := env.routeService.UpdateRouteStatus(ctx, routeB.ID, models.RouteStatusActive); err != nil {
+		t.Errorf("starting route B after cancelling route A: %v", err)
+	}
+}
+
+func TestRouteService_UpdatePackageDeliveryStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		start        bool
+		redeliver    bool
+		failed       bool
+		offRoute     bool
+		delivered    bool
+		proof        *DeliveryProof
+		wantErr      bool
+		wantConflict bool
+		wantStatus   models.PackageStatus
+	}{
+		{name: "deliver a stop", start: true, delivered: true, wantStatus: models.PackageStatusDelivered},
+		{name: "deliver with proof", start: true, delivered: true, proof: &DeliveryProof{RecipientName: "Bob"}, wantStatus: models.PackageStatusDelivered},
+		{name: "undo a delivery", start: true, redeliver: true, delivered: false, wantStatus: models.PackageStatusOutForDelivery},
+		{name: "route not started", delivered: true, wantErr: true},
+		{name: "invalid proof", start: true, delivered: true, proof: &DeliveryProof{}, wantErr: true},
+		{name: "package not on the route", start: true, offRoute: true, delivered: true, wantErr: true},
+		{name: "stop already failed", start: true, failed: true, delivered: true, wantErr: true, wantConflict: true},
+		{name: "stop already delivered", start: true, redeliver: true, delivered: true, wantErr: true, wantConflict: true},
+		{name: "undo a stop not delivered", start: true, delivered: false, wantErr: true, wantConflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var route *models.Route
+			var packages []*models.Package
+			if tt.start {
+				route, packages = env.createActiveRoute(t, 2)
+			} else {
+				route, packages = env.createRoute(t, 2)
+			}
+			pkgID := packages[0].ID
+			if tt.offRoute {
+				pkgID = env.createPackage(t, "TRK-OFF", 0.5).ID
+			}
+			if tt.failed {
+				if _, err := env.routeService.RecordFailedDeliveryAttempt(ctx, route.ID, pkgID, models.FailureReasonRefused, ""); err != nil {
+					t.Fatalf("RecordFailedDeliveryAttempt: %v", err)
+				}
+			}
+			if tt.redeliver {
+				if _, err := env.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, pkgID, true, nil); err != nil {
+					t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+				}
+			}
+
+			before := env.getRoute(t, route.ID)
+			events := env.eventTypes(t, pkgID)
+
+			updated, err := env.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, pkgID, tt.delivered, tt.proof)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdatePackageDeliveryStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantConflict {
+				if !errors.Is(err, models.ErrConflict) {
+					t.Errorf("UpdatePackageDeliveryStatus() error = %v, want a conflict", err)
+				}
+				// A stop in the wrong state is rejected before anything is written
+				if stored := env.getRoute(t, route.ID); stored.Version != before.Version {
+					t.Errorf("route version = %d, want it unchanged at %d", stored.Version, before.Version)
+				}
+				if got := env.eventTypes(t, pkgID); !slices.Equal(got, events) {
+					t.Errorf("events = %v, want them unchanged at %v", got, events)
+				}
+			}
+			if tt.wantErr {
+				return
+			}
+
+			pkg := env.getPackage(t, pkgID)
+			if pkg.Status != tt.wantStatus || pkg.Delivered != tt.delivered {
+				t.Errorf("package = %s (delivered %v), want %s", pkg.Status, pkg.Delivered, tt.wantStatus)
+			}
+			if (pkg.ProofOfDelivery != nil) != (tt.proof != nil) {
+				t.Errorf("proof of delivery = %+v, want one only when given", pkg.ProofOfDelivery)
+			}
+
+			// The returned route is the stored one; the delivered stop alone carries a delivery time
+			stored := env.getRoute(t, route.ID)
+			if updated.Version != stored.Version {
+				t.Errorf("returned route version = %d, want the stored %d", updated.Version, stored.Version)
+			}
+			if stop := stored.Stop(pkgID); stop.Delivered != tt.delivered || (stop.DeliveryTimestamp != nil) != tt.delivered {
+				t.Errorf("stop = %+v, want delivered %v with a delivery time only when delivered", stop, tt.delivered)
+			}
+
+			// The remaining stop keeps a projected arrival while the delivered one loses it
+			if stored.Stop(packages[1].ID).ProjectedArrival == nil {
+				t.Error("remaining stop has no projected arrival")
+			}
+		})
+	}
+}
+
+func TestRouteService_DeliverPackage(t *testing.T) {
+	tests := []struct {
+		name          string
+		start         bool
+		offRoute      bool
+		proof         *DeliveryProof
+		wantErr       bool
+		wantErrIs     error
+		wantRecipient string
+	}{
+		{name: "package out for delivery", start: true},
+		{
+			name:  "with proof of delivery",
+			start: true,
+			proof: &DeliveryProof{
+				RecipientName: "Bob",
+				Location:      testDepot,
+				Signature:     &Attachment{ContentType: "image/png", Data: []byte("signature")},
+			},
+			wantRecipient: "Bob",
+		},
+		{name: "proof without recipient", start: true, proof: &DeliveryProof{}, wantErr: true},
+		{name: "route not started", wantErr: true, wantErrIs: models.ErrConflict},
+		{name: "package not on a route", offRoute: true, wantErr: true, wantErrIs: models.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			var route *models.Route
+			var packages []*models.Package
+			if tt.start {
+				route, packages = env.createActiveRoute(t, 1)
+			} else {
+				route, packages = env.createRoute(t, 1)
+			}
+			id := packages[0].ID
+			if tt.offRoute {
+				id = env.createPackage(t, "TRK-OFF", 0.5).ID
+			}
+
+			pkg, err := env.routeService.DeliverPackage(ctx, id, tt.proof)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeliverPackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Fatalf("DeliverPackage() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !pkg.Delivered || pkg.DeliveryTimestamp == nil || pkg.Status != models.PackageStatusDelivered {
+				t.Errorf("DeliverPackage() = %+v, want a delivered package", pkg)
+			}
+			if tt.wantRecipient != "" && (pkg.ProofOfDelivery == nil || pkg.ProofOfDelivery.RecipientName != tt.wantRecipient) {
+				t.Errorf("proof of delivery = %+v, want recipient %q", pkg.ProofOfDelivery, tt.wantRecipient)
+			}
+
+			// The stop is delivered with the package, so the route can complete
+			if stop := env.getRoute(t, route.ID).Stop(id); !stop.Delivered || stop.DeliveryTimestamp == nil {
+				t.Errorf("stop = %+v, want it delivered", stop)
+			}
+			if err := env.routeService.UpdateRouteStatus(ctx, route.ID, models.RouteStatusCompleted); err != nil {
+				t.Errorf("UpdateRouteStatus(completed) error = %v", err)
+			}
+		})
+	}
+}
+
+func TestRouteService_UnitOfWork(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	route, packages := env.createRoute(t, 2)
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{name: "start the route", run: func() error {
+			return env.routeService.UpdateRouteStatus(ctx, route.ID, models.RouteStatusActive)
+		}},
+		{name: "deliver a stop", run: func() error {
+			_, err := env.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, packages[0].ID, true, nil)
+			return err
+		}},
+		{name: "fail a stop", run: func() error {
+			_, err := env.routeService.RecordFailedDeliveryAttempt(ctx, route.ID, packages[1].ID, models.FailureReasonCustomerNotHome, "")
+			return err
+		}},
+		{name: "cancel the route", run: func() error {
+			return env.routeService.UpdateRouteStatus(ctx, route.ID, models.RouteStatusCancelled)
+		}},
+	}
+
+	for _, step := range steps {
+		env.uow.units = 0
+		if err := step.run(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if env.uow.units != 1 {
+			t.Errorf("%s ran in %d units of work, want 1", step.name, env.uow.units)
+		}
+	}
+}
+
+func TestRouteService_RecordFailedDeliveryAttempt(t *testing.T) {
+	tests := []struct {
+		name         string
+		start        bool
+		priorFails   int
+		reason       models.FailureReason
+		wantErr      bool
+		wantStatus   models.PackageStatus
+		wantAttempts int
+	}{
+		{name: "first attempt re-queues the package", start: true, reason: models.FailureReasonCustomerNotHome, wantStatus: models.PackageStatusPending, wantAttempts: 1},
+		{name: "last attempt returns the package", start: true, priorFails: testMaxDeliveryAttempts - 1, reason: models.FailureReasonRefused, wantStatus: models.PackageStatusReturned, wantAttempts: testMaxDeliveryAttempts},
+		{name: "unknown reason", start: true, reason: "dog", wantErr: true},
+		{name: "route not started", reason: models.FailureReasonCustomerNotHome, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+
+			var route *models.Route
+			var packages []*models.Package
+			if tt.start {
+				route, packages = env.createActiveRoute(t, 1)
+			} else {
+				route, packages = env.createRoute(t, 1)
+			}
+			pkgID := packages[0].ID
+			if tt.priorFails > 0 {
+				pkg := env.getPackage(t, pkgID)
+				pkg.FailedAttempts = tt.priorFails
+				if err := env.packages.Update(ctx, pkg); err != nil {
+					t.Fatalf("Update: %v", err)
+				}
+			}
+
+			pkg, err := env.routeService.RecordFailedDeliveryAttempt(ctx, route.ID, pkgID, tt.reason, "rang twice")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RecordFailedDeliveryAttempt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if stop := env.getRoute(t, route.ID).Packages[0]; stop.Failed {
+					t.Error("stop marked failed after a rejected attempt")
+				}
+				return
+			}
+
+			if pkg.Status != tt.wantStatus || pkg.FailedAttempts != tt.wantAttempts {
+				t.Errorf("package = %s after %d attempts, want %s after %d", pkg.Status, pkg.FailedAttempts, tt.wantStatus, tt.wantAttempts)
+			}
+			if pkg.LastFailure == nil || pkg.LastFailure.Reason != tt.reason || pkg.LastFailure.Note != "rang twice" {
+				t.Errorf("last failure = %+v, want reason %s", pkg.LastFailure, tt.reason)
+			}
+
+			stored := env.getRoute(t, route.ID)
+			if stop := stored.Packages[0]; !stop.Failed || stop.FailureReason != tt.reason {
+				t.Errorf("stop = %+v, want it failed with reason %s", stop, tt.reason)
+			}
+			// A failed stop no longer blocks completing the route
+			if err := env.routeService.UpdateRouteStatus(ctx, route.ID, models.RouteStatusCompleted); err != nil {
+				t.Errorf("completing the route after the attempt: %v", err)
+			}
+
+			want := []models.PackageEventType{models.PackageEventCreated, models.PackageEventAssigned, models.PackageEventStatusChanged, models.PackageEventDeliveryFailed, models.PackageEventStatusChanged}
+			if got := env.eventTypes(t, pkgID); len(got) != len(want) || got[3] != want[3] {
+				t.Errorf("events = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRouteService_GetRouteETA(t *testing.T) {
+	tests := []struct {
+		name          string
+		start         bool
+		wantProjected bool
+	}{
+		{name: "pending route projects the plan", wantProjected: true},
+		{name: "active route projects from now", start: true, wantProjected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			var route *models.Route
+			if tt.start {
+				route, _ = env.createActiveRoute(t, 2)
+			} else {
+				route, _ = env.createRoute(t, 2)
+			}
+
+			got, err := env.routeService.GetRouteETA(context.Background(), route.ID)
+			if err != nil {
+				t.Fatalf("GetRouteETA() error = %v", err)
+			}
+			for _, stop := range got.Packages {
+				if (stop.ProjectedArrival != nil) != tt.wantProjected {
+					t.Errorf("stop %d projected arrival = %v, want projected %v", stop.OrderInRoute, stop.ProjectedArrival, tt.wantProjected)
+				}
+			}
+			for i := 1; i < len(got.Packages); i++ {
+				if got.Packages[i].ProjectedArrival.Before(*got.Packages[i-1].ProjectedArrival) {
+					t.Errorf("stop %d is projected before stop %d", i+1, i)
+				}
+			}
+		})
+	}
+
+	t.Run("unknown route", func(t *testing.T) {
+		env := newTestEnv(t)
+		if _, err := env.routeService.GetRouteETA(context.Background(), primitive.NewObjectID()); err == nil {
+			t.Error("GetRouteETA() error = nil, want an error")
+		}
+	})
+}
+
+func TestRouteService_UpdateRoute(t *testing.T) {
+	nextDay := testDate.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name        string
+		vehicleType models.VehicleType
+		inactive    bool
+		unknown     bool
+		date        time.Time
+		stale       bool
+		wantErr     error
+	}{
+		{name: "another van on the next day", vehicleType: models.VehicleTypeVan, date: nextDay},
+		{name: "bike over capacity", vehicleType: models.VehicleTypeBike, date: nextDay, wantErr: models.ErrCapacityExceeded},
+		{name: "inactive driver", vehicleType: models.VehicleTypeVan, inactive: true, date: nextDay, wantErr: models.ErrConflict},
+		{name: "unknown driver", unknown: true, date: nextDay, wantErr: models.ErrNotFound},
+		{name: "missing date", vehicleType: models.VehicleTypeVan, wantErr: models.ErrValidation},
+		{name: "stale version", vehicleType: models.VehicleTypeVan, date: nextDay, stale: true, wantErr: models.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			// Two packages weighing 30 kg in all, over a bike's capacity
+			route, _ := env.createRoute(t, 0)
+			if err := env.routeService.AddPackagesToRoute(ctx, route.ID, env.createHeavyPackages(t, 2)); err != nil {
+				t.Fatalf("AddPackagesToRoute: %v", err)
+			}
+			route = env.getRoute(t, route.ID)
+
+			driverID := primitive.NewObjectID()
+			if !tt.unknown {
+				driver := env.createDriver(t, tt.vehicleType)
+				if tt.inactive {
+					if _, err := env.driverService.UpdateDriver(ctx, driver.ID, 0, driver.Name, driver.VehicleType, false, nil); err != nil {
+						t.Fatalf("UpdateDriver: %v", err)
+					}
+				}
+				driverID = driver.ID
+			}
+			version := route.Version
+			if tt.stale {
+				version--
+			}
+
+			updated, err := env.routeService.UpdateRoute(ctx, route.ID, version, driverID, tt.date)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateRoute() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored := env.getRoute(t, route.ID)
+			if tt.wantErr != nil {
+				if stored.DriverID != route.DriverID || stored.Version != route.Version {
+					t.Errorf("stored route = %+v, want it unchanged", stored)
+				}
+				return
+			}
+			if stored.DriverID != driverID || !stored.Date.Equal(tt.date) || stored.Version != route.Version+1 || updated.Version != stored.Version {
+				t.Errorf("stored route = %+v, want it reassigned at version %d", stored, route.Version+1)
+			}
+			// The estimates are recalculated for the new day rather than kept from the old one
+			if stored.EstimatedDistanceKm != route.EstimatedDistanceKm || stored.LoadWeightKg != 30 {
+				t.Errorf("stored estimates = %.2f km for %.2f kg, want %.2f km for 30 kg", stored.EstimatedDistanceKm, stored.LoadWeightKg, route.EstimatedDistanceKm)
+			}
+			for _, stop := range stored.Packages {
+				if stop.PlannedArrival == nil || stop.PlannedArrival.Day() != tt.date.Day() {
+					t.Errorf("stop planned at %v, want an arrival on %v", stop.PlannedArrival, tt.date)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteService_DeleteRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		unknown bool
+	}{
+		{name: "existing route"},
+		{name: "unknown route is a no-op", unknown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			ctx := context.Background()
+			route, _ := env.createRoute(t, 0)
+			id := route.ID
+			if tt.unknown {
+				id = primitive.NewObjectID()
+			}
+
+			if err := env.routeService.DeleteRoute(ctx, id); err != nil {
+				t.Fatalf("DeleteRoute() error = %v", err)
+			}
+			routes, _ := env.routeService.ListRoutes(ctx, repositories.RouteFilter{}, repositories.PageRequest{})
+			if want := map[bool]int{true: 1, false: 0}[tt.unknown]; len(routes.Items) != want {
+				t.Errorf("%d routes left, want %d", len(routes.Items), want)
+			}
+		})
+	}
+}
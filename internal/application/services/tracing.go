@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates the spans of the service methods from the global tracer provider
+var tracer = otel.Tracer("github.com/Arcanm/deliveryPlannerGolang/internal/application/services")
+
+// startSpan starts the span of a service method as a child of the span in ctx
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name)
+}
+
+// endSpan ends the span of a service method, marking it failed when the method returns an error;
+// it is deferred with the address of the method's named error result
+func endSpan(span trace.Span, err *error) {
+	if *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
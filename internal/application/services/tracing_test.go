@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spans records every span ended by the tests; the global provider can only be installed once
+var spans = tracetest.NewSpanRecorder()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+}
+
+// tracedSpans returns the ended spans of the trace that run starts, keyed by name
+func tracedSpans(t *testing.T, run func(ctx context.Context)) map[string]sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	ctx, root := otel.Tracer("test").Start(context.Background(), "test")
+	run(ctx)
+	root.End()
+
+	traced := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range spans.Ended() {
+		if span.SpanContext().TraceID() == root.SpanContext().TraceID() {
+			traced[span.Name()] = span
+		}
+	}
+	return traced
+}
+
+func TestServiceSpans(t *testing.T) {
+	tests := []struct {
+		name       string
+		unknown    bool
+		wantStatus codes.Code
+	}{
+		{name: "successful call", wantStatus: codes.Unset},
+		{name: "failed call", unknown: true, wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			route, _ := env.createRoute(t, 0)
+			ids := []primitive.ObjectID{env.createPackage(t, "PKG-1", 0.01).ID}
+			if tt.unknown {
+				ids = append(ids, primitive.NewObjectID())
+			}
+
+			var parent trace.SpanContext
+			traced := tracedSpans(t, func(ctx context.Context) {
+				parent = trace.SpanContextFromContext(ctx)
+				_ = env.routeService.AddPackagesToRoute(ctx, route.ID, ids)
+			})
+
+			span, ok := traced["RouteService.AddPackagesToRoute"]
+			if !ok {
+				t.Fatalf("spans = %v, want RouteService.AddPackagesToRoute", traced)
+			}
+			if span.Parent().SpanID() != parent.SpanID() {
+				t.Errorf("parent = %s, want the caller's span %s", span.Parent().SpanID(), parent.SpanID())
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", span.Status(), tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// FailureReason explains why a delivery attempt did not succeed
+type FailureReason string
+
+const (
+	FailureReasonCustomerNotHome  FailureReason = "customer_not_home"
+	FailureReasonAddressNotFound  FailureReason = "address_not_found"
+	FailureReasonRefused          FailureReason = "refused"
+	FailureReasonDamaged          FailureReason = "damaged"
+	FailureReasonAccessRestricted FailureReason = "access_restricted"
+	FailureReasonOther            FailureReason = "other"
+)
+
+// IsValid reports whether the reason is one of the known failure codes
+func (r FailureReason) IsValid() bool {
+	switch r {
+	case FailureReasonCustomerNotHome, FailureReasonAddressNotFound, FailureReasonRefused,
+		FailureReasonDamaged, FailureReasonAccessRestricted, FailureReasonOther:
+		return true
+	}
+	return false
+}
+
+// DeliveryFailure describes the most recent failed delivery attempt of a package
+type DeliveryFailure struct {
+	Reason   FailureReason `bson:"reason" json:"reason"`
+	Note     string        `bson:"note,omitempty" json:"note,omitempty"`
+	Attempt  int           `bson:"attempt" json:"attempt"`
+	FailedAt time.Time     `bson:"failed_at" json:"failed_at"`
+}
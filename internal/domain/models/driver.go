@@ -15,14 +15,90 @@ const (
 	VehicleTypeTruck VehicleType = "truck"
 )
 
+// SpeedProfile describes how fast a vehicle type moves through traffic
+type SpeedProfile struct {
+	AverageSpeedKmh float64
+}
+
+// defaultSpeedProfile is used for vehicle types without a dedicated profile
+var defaultSpeedProfile = SpeedProfile{AverageSpeedKmh: 30}
+
+// speedProfiles holds the average urban speeds used to estimate travel times
+var speedProfiles = map[VehicleType]SpeedProfile{
+	VehicleTypeBike:  {AverageSpeedKmh: 15},
+	VehicleTypeVan:   {AverageSpeedKmh: 35},
+	VehicleTypeTruck: {AverageSpeedKmh: 28},
+}
+
+// VehicleCapacity describes the maximum load a vehicle can carry
+type VehicleCapacity struct {
+	MaxWeightKg float64 `bson:"max_weight_kg" json:"max_weight_kg" yaml:"max_weight_kg"`
+	MaxVolumeM3 float64 `bson:"max_volume_m3" json:"max_volume_m3" yaml:"max_volume_m3"`
+}
+
+// Fits reports whether the given load stays within the capacity
+func (c VehicleCapacity) Fits(weightKg, volumeM3 float64) bool {
+	return weightKg <= c.MaxWeightKg && volumeM3 <= c.MaxVolumeM3
+}
+
+// Validate checks that the limits are positive
+func (c VehicleCapacity) Validate() error {
+	if c.MaxWeightKg <= 0 || c.MaxVolumeM3 <= 0 {
+		return Validationf("invalid capacity: weight and volume limits must be positive")
+	}
+	return nil
+}
+
+// VehicleCapacities maps each vehicle type to its load limits
+type VehicleCapacities map[VehicleType]VehicleCapacity
+
+// DefaultVehicleCapacities holds the standard load limits for each vehicle type
+var DefaultVehicleCapacities = VehicleCapacities{
+	VehicleTypeBike:  {MaxWeightKg: 20, MaxVolumeM3: 0.15},
+	VehicleTypeVan:   {MaxWeightKg: 800, MaxVolumeM3: 6},
+	VehicleTypeTruck: {MaxWeightKg: 3500, MaxVolumeM3: 20},
+}
+
+// ForDriver returns the driver's own limits when overridden, otherwise those of its vehicle type
+func (c VehicleCapacities) ForDriver(driver *Driver) VehicleCapacity {
+	if driver.CapacityOverride != nil {
+		return *driver.CapacityOverride
+	}
+	if capacity, ok := c[driver.VehicleType]; ok {
+		return capacity
+	}
+	return DefaultVehicleCapacities[driver.VehicleType]
+}
+
+// IsValid reports whether the vehicle type is one the fleet supports
+func (v VehicleType) IsValid() bool {
+	_, ok := speedProfiles[v]
+	return ok
+}
+
+// SpeedProfile returns the speed profile for the vehicle type
+func (v VehicleType) SpeedProfile() SpeedProfile {
+	if profile, ok := speedProfiles[v]; ok {
+		return profile
+	}
+	return defaultSpeedProfile
+}
+
+// TravelTimeMin returns the minutes needed to cover the given distance
+func (p SpeedProfile) TravelTimeMin(distanceKm float64) float64 {
+	return distanceKm / p.AverageSpeedKmh * 60.0
+}
+
 // Driver represents a delivery driver
 type Driver struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty"`
-	Name        string             `bson:"name"`
-	VehicleType VehicleType        `bson:"vehicle_type"`
-	Active      bool               `bson:"active"`
-	CreatedAt   time.Time          `bson:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at"`
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	Name             string             `bson:"name"`
+	VehicleType      VehicleType        `bson:"vehicle_type"`
+	Active           bool               `bson:"active"`
+	CapacityOverride *VehicleCapacity   `bson:"capacity_override,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+	Version          int64              `bson:"version"`
 }
 
 // NewDriver creates a new driver instance
@@ -39,6 +115,11 @@ func NewDriver(name string, vehicleType VehicleType) *Driver {
 
 // Validate performs basic validation on the driver
 func (d *Driver) Validate() error {
-	// TODO: Implement validation logic
+	if d.CapacityOverride != nil {
+		if err := d.CapacityOverride.Validate(); err != nil {
+			return err
+		}
+	}
+	// TODO: Implement remaining validation logic
 	return nil
 }
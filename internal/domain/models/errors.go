@@ -1,8 +1,85 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+// Error kinds every domain error belongs to, so the transports can map them without knowing each error
+var (
+	// ErrNotFound is returned when no entity matches the requested ID or key
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict is returned when a change clashes with the current state of an entity
+	ErrConflict = errors.New("conflict")
+
+	// ErrValidation is returned when the input of a change breaks a domain rule
+	ErrValidation = errors.New("validation failed")
+
+	// ErrInvalidTransition is returned when a status change is not allowed by the entity's lifecycle
+	ErrInvalidTransition = errors.New("invalid status transition")
+
+	// ErrCapacityExceeded is returned when a route's load does not fit in the driver's vehicle
+	ErrCapacityExceeded = errors.New("route load exceeds vehicle capacity")
+)
 
 var (
 	// ErrRouteHasPendingPackages is returned when trying to complete a route with pending packages
-	ErrRouteHasPendingPackages = errors.New("cannot complete route: there are pending packages")
+	ErrRouteHasPendingPackages = Conflictf("cannot complete route: there are pending packages")
+
+	// ErrProofOfDeliveryNotFound is returned when a package was delivered without capturing proof
+	ErrProofOfDeliveryNotFound = NotFoundf("package has no proof of delivery")
 )
+
+// Error is a domain error of a given kind, carrying its own message
+type Error struct {
+	Kind error
+	err  error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap allows errors.Is against both the kind and any error wrapped in the message
+func (e *Error) Unwrap() []error {
+	if wrapped := errors.Unwrap(e.err); wrapped != nil {
+		return []error{e.Kind, wrapped}
+	}
+	return []error{e.Kind}
+}
+
+func newError(kind error, format string, args ...any) *Error {
+	return &Error{Kind: kind, err: fmt.Errorf(format, args...)}
+}
+
+// NotFoundf formats a not found error
+func NotFoundf(format string, args ...any) error {
+	return newError(ErrNotFound, format, args...)
+}
+
+// Conflictf formats a conflict error
+func Conflictf(format string, args ...any) error {
+	return newError(ErrConflict, format, args...)
+}
+
+// Validationf formats a validation error
+func Validationf(format string, args ...any) error {
+	return newError(ErrValidation, format, args...)
+}
+
+// TransitionError describes a status change rejected by a lifecycle
+type TransitionError struct {
+	Entity string
+	From   string
+	To     string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("invalid %s status transition from %q to %q", e.Entity, e.From, e.To)
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidTransition)
+func (e *TransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"math"
+)
+
+// earthRadiusKm is the mean radius of the Earth used by the haversine formula
+const earthRadiusKm = 6371.0
+
+// Location represents a geographical location
+type Location struct {
+	Latitude  float64 `bson:"latitude" json:"latitude"`
+	Longitude float64 `bson:"longitude" json:"longitude"`
+}
+
+// DistanceKm returns the great-circle distance to another location using the haversine formula
+func (l Location) DistanceKm(other Location) float64 {
+	lat1 := toRadians(l.Latitude)
+	lat2 := toRadians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(other.Longitude - l.Longitude)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadiusKm * c
+}
+
+// Validate checks that the coordinates are within valid ranges
+func (l Location) Validate() error {
+	if l.Latitude < -90 || l.Latitude > 90 {
+		return Validationf("invalid latitude %f: must be between -90 and 90", l.Latitude)
+	}
+	if l.Longitude < -180 || l.Longitude > 180 {
+		return Validationf("invalid longitude %f: must be between -180 and 180", l.Longitude)
+	}
+	return nil
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -10,60 +10,170 @@ import (
 type PackageStatus string
 
 const (
-	PackageStatusPending   PackageStatus = "pending"
-	PackageStatusAssigned  PackageStatus = "assigned"
-	PackageStatusDelivered PackageStatus = "delivered"
-	PackageStatusCancelled PackageStatus = "cancelled"
+	PackageStatusPending        PackageStatus = "pending"
+	PackageStatusAssigned       PackageStatus = "assigned"
+	PackageStatusOutForDelivery PackageStatus = "out_for_delivery"
+	PackageStatusDelivered      PackageStatus = "delivered"
+	PackageStatusFailed         PackageStatus = "failed"
+	PackageStatusReturned       PackageStatus = "returned"
+	PackageStatusCancelled      PackageStatus = "cancelled"
 )
 
-// Location represents a geographical location
-type Location struct {
-	Latitude  float64 `bson:"latitude" json:"latitude"`
-	Longitude float64 `bson:"longitude" json:"longitude"`
+// packageTransitions lists the statuses a package may move to from each status
+var packageTransitions = map[PackageStatus][]PackageStatus{
+	PackageStatusPending:        {PackageStatusAssigned, PackageStatusCancelled},
+	PackageStatusAssigned:       {PackageStatusOutForDelivery, PackageStatusPending, PackageStatusCancelled},
+	PackageStatusOutForDelivery: {PackageStatusDelivered, PackageStatusFailed, PackageStatusPending},
+	PackageStatusDelivered:      {PackageStatusOutForDelivery},
+	PackageStatusFailed:         {PackageStatusPending, PackageStatusReturned},
+	PackageStatusReturned:       {},
+	PackageStatusCancelled:      {},
+}
+
+// CanTransitionTo reports whether the lifecycle allows moving from this status to the next one
+func (s PackageStatus) CanTransitionTo(next PackageStatus) bool {
+	for _, allowed := range packageTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValid reports whether the status is part of the package lifecycle
+func (s PackageStatus) IsValid() bool {
+	_, ok := packageTransitions[s]
+	return ok
 }
 
 // Package represents a delivery package
 type Package struct {
-	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	TrackingNumber    string             `bson:"tracking_number" json:"tracking_number"`
-	CustomerName      string             `bson:"customer_name" json:"customer_name"`
-	CustomerAddress   string             `bson:"customer_address" json:"customer_address"`
-	CustomerPhone     string             `bson:"customer_phone" json:"customer_phone"`
-	WeightKg          float64            `bson:"weight_kg" json:"weight_kg"`
-	VolumeM3          float64            `bson:"volume_m3" json:"volume_m3"`
-	Delivered         bool               `bson:"delivered" json:"delivered"`
-	DeliveryTimestamp *time.Time         `bson:"delivery_timestamp,omitempty" json:"delivery_timestamp,omitempty"`
-	CreatedAt         time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt         time.Time          `bson:"updated_at" json:"updated_at"`
+	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	TrackingNumber     string             `bson:"tracking_number" json:"tracking_number"`
+	CustomerName       string             `bson:"customer_name" json:"customer_name"`
+	CustomerAddress    string             `bson:"customer_address" json:"customer_address"`
+	CustomerPhone      string             `bson:"customer_phone" json:"customer_phone"`
+	WeightKg           float64            `bson:"weight_kg" json:"weight_kg"`
+	VolumeM3           float64            `bson:"volume_m3" json:"volume_m3"`
+	Status             PackageStatus      `bson:"status" json:"status"`
+	Location           *Location          `bson:"location,omitempty" json:"location,omitempty"`
+	DeliveryWindow     *TimeWindow        `bson:"delivery_window,omitempty" json:"delivery_window,omitempty"`
+	ServiceDurationMin int                `bson:"service_duration_min" json:"service_duration_min"`
+	Delivered          bool               `bson:"delivered" json:"delivered"`
+	DeliveryTimestamp  *time.Time         `bson:"delivery_timestamp,omitempty" json:"delivery_timestamp,omitempty"`
+	FailedAttempts     int                `bson:"failed_attempts" json:"failed_attempts"`
+	LastFailure        *DeliveryFailure   `bson:"last_failure,omitempty" json:"last_failure,omitempty"`
+	ProofOfDelivery    *ProofOfDelivery   `bson:"proof_of_delivery,omitempty" json:"proof_of_delivery,omitempty"`
+	CreatedAt          time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at" json:"updated_at"`
+	Version            int64              `bson:"version" json:"version"`
 }
 
 // NewPackage creates a new package instance
-func NewPackage(trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64) *Package {
+func NewPackage(trackingNumber, customerName, customerAddress, customerPhone string, weightKg, volumeM3 float64, location *Location, deliveryWindow *TimeWindow, serviceDurationMin int) *Package {
 	now := time.Now()
 	return &Package{
-		TrackingNumber:    trackingNumber,
-		CustomerName:      customerName,
-		CustomerAddress:   customerAddress,
-		CustomerPhone:     customerPhone,
-		WeightKg:          weightKg,
-		VolumeM3:          volumeM3,
-		Delivered:         false,
-		DeliveryTimestamp: nil,
-		CreatedAt:         now,
-		UpdatedAt:         now,
+		TrackingNumber:     trackingNumber,
+		CustomerName:       customerName,
+		CustomerAddress:    customerAddress,
+		CustomerPhone:      customerPhone,
+		WeightKg:           weightKg,
+		VolumeM3:           volumeM3,
+		Status:             PackageStatusPending,
+		Location:           location,
+		DeliveryWindow:     deliveryWindow,
+		ServiceDurationMin: serviceDurationMin,
+		Delivered:          false,
+		DeliveryTimestamp:  nil,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 }
 
-// MarkAsDelivered marks the package as delivered
-func (p *Package) MarkAsDelivered() {
+// CurrentStatus returns the package status, deriving it for packages stored before the status was tracked
+func (p *Package) CurrentStatus() PackageStatus {
+	if p.Status != "" {
+		return p.Status
+	}
+	if p.Delivered {
+		return PackageStatusDelivered
+	}
+	return PackageStatusPending
+}
+
+// TransitionTo moves the package to the given status if the lifecycle allows it
+func (p *Package) TransitionTo(status PackageStatus) error {
+	current := p.CurrentStatus()
+	if !current.CanTransitionTo(status) {
+		return &TransitionError{Entity: "package", From: string(current), To: string(status)}
+	}
+
 	now := time.Now()
-	p.Delivered = true
-	p.DeliveryTimestamp = &now
+	p.Status = status
+	p.Delivered = status == PackageStatusDelivered
+	if p.Delivered {
+		p.DeliveryTimestamp = &now
+	} else {
+		p.DeliveryTimestamp = nil
+		p.ProofOfDelivery = nil
+	}
 	p.UpdatedAt = now
+	return nil
+}
+
+// MarkAsDelivered marks the package as delivered
+func (p *Package) MarkAsDelivered() error {
+	return p.TransitionTo(PackageStatusDelivered)
+}
+
+// RecordFailedAttempt marks the current delivery attempt as failed and counts it
+func (p *Package) RecordFailedAttempt(reason FailureReason, note string) error {
+	if !reason.IsValid() {
+		return Validationf("unknown failure reason %q", reason)
+	}
+	if err := p.TransitionTo(PackageStatusFailed); err != nil {
+		return err
+	}
+
+	p.FailedAttempts++
+	p.LastFailure = &DeliveryFailure{
+		Reason:   reason,
+		Note:     note,
+		Attempt:  p.FailedAttempts,
+		FailedAt: p.UpdatedAt,
+	}
+	return nil
+}
+
+// StatusAfterFailure returns where a failed package goes next: back to the pending pool, or
+// returned to the sender once it has used up its delivery attempts
+func (p *Package) StatusAfterFailure(maxAttempts int) PackageStatus {
+	if p.FailedAttempts >= maxAttempts {
+		return PackageStatusReturned
+	}
+	return PackageStatusPending
+}
+
+// ServiceDuration returns how long the driver is expected to spend at the stop
+func (p *Package) ServiceDuration() time.Duration {
+	return time.Duration(p.ServiceDurationMin) * time.Minute
 }
 
 // Validate performs basic validation on the package
 func (p *Package) Validate() error {
-	// TODO: Implement validation logic
+	if p.Location != nil {
+		if err := p.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.DeliveryWindow != nil {
+		if err := p.DeliveryWindow.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.ServiceDurationMin < 0 {
+		return Validationf("invalid service duration: must not be negative")
+	}
+	// TODO: Implement remaining validation logic
 	return nil
 }
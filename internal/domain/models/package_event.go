@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PackageEventType identifies what happened to a package
+type PackageEventType string
+
+const (
+	PackageEventCreated        PackageEventType = "created"
+	PackageEventUpdated        PackageEventType = "updated"
+	PackageEventAssigned       PackageEventType = "assigned"
+	PackageEventStatusChanged  PackageEventType = "status_changed"
+	PackageEventDelivered      PackageEventType = "delivered"
+	PackageEventDeliveryFailed PackageEventType = "delivery_failed"
+)
+
+// PackageEvent is an entry in a package's append-only tracking history
+type PackageEvent struct {
+	ID         primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	PackageID  primitive.ObjectID  `bson:"package_id" json:"package_id"`
+	Type       PackageEventType    `bson:"type" json:"type"`
+	Actor      string              `bson:"actor" json:"actor"`
+	FromStatus PackageStatus       `bson:"from_status,omitempty" json:"from_status,omitempty"`
+	ToStatus   PackageStatus       `bson:"to_status,omitempty" json:"to_status,omitempty"`
+	RouteID    *primitive.ObjectID `bson:"route_id,omitempty" json:"route_id,omitempty"`
+	Location   *Location           `bson:"location,omitempty" json:"location,omitempty"`
+	Note       string              `bson:"note,omitempty" json:"note,omitempty"`
+	Timestamp  time.Time           `bson:"timestamp" json:"timestamp"`
+}
+
+// NewPackageEvent creates a new event for a package
+func NewPackageEvent(packageID primitive.ObjectID, eventType PackageEventType, actor string) *PackageEvent {
+	return &PackageEvent{
+		PackageID: packageID,
+		Type:      eventType,
+		Actor:     actor,
+		Timestamp: time.Now(),
+	}
+}
+
+// NewPackageStatusEvent creates the event recording a package's move from one status to its current one
+func NewPackageStatusEvent(pkg *Package, from PackageStatus, actor string) *PackageEvent {
+	event := NewPackageEvent(pkg.ID, packageEventTypeForStatus(pkg.Status), actor)
+	event.FromStatus = from
+	event.ToStatus = pkg.Status
+	return event
+}
+
+// packageEventTypeForStatus picks the most specific event type for a status change
+func packageEventTypeForStatus(status PackageStatus) PackageEventType {
+	switch status {
+	case PackageStatusAssigned:
+		return PackageEventAssigned
+	case PackageStatusDelivered:
+		return PackageEventDelivered
+	case PackageStatusFailed:
+		return PackageEventDeliveryFailed
+	default:
+		return PackageEventStatusChanged
+	}
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PlanStatus represents the current status of a fleet plan
+type PlanStatus string
+
+const (
+	PlanStatusDraft     PlanStatus = "draft"
+	PlanStatusConfirmed PlanStatus = "confirmed"
+)
+
+// ErrPlanAlreadyConfirmed is returned when trying to confirm a plan twice
+var ErrPlanAlreadyConfirmed = Conflictf("plan has already been confirmed")
+
+// PlannedRoute represents the stops proposed for one driver in a fleet plan
+type PlannedRoute struct {
+	DriverID            primitive.ObjectID   `bson:"driver_id" json:"driver_id"`
+	VehicleType         VehicleType          `bson:"vehicle_type" json:"vehicle_type"`
+	PackageIDs          []primitive.ObjectID `bson:"package_ids" json:"package_ids"`
+	LoadWeightKg        float64              `bson:"load_weight_kg" json:"load_weight_kg"`
+	LoadVolumeM3        float64              `bson:"load_volume_m3" json:"load_volume_m3"`
+	EstimatedDistanceKm float64              `bson:"estimated_distance_km" json:"estimated_distance_km"`
+	EstimatedTimeMin    int                  `bson:"estimated_time_min" json:"estimated_time_min"`
+}
+
+// Plan represents a proposed distribution of packages across the active fleet for a day
+type Plan struct {
+	ID                   primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	Date                 time.Time            `bson:"date" json:"date"`
+	StartLocation        *Location            `bson:"start_location,omitempty" json:"start_location,omitempty"`
+	Routes               []PlannedRoute       `bson:"routes" json:"routes"`
+	UnassignedPackageIDs []primitive.ObjectID `bson:"unassigned_package_ids" json:"unassigned_package_ids"`
+	TotalDistanceKm      float64              `bson:"total_distance_km" json:"total_distance_km"`
+	Status               PlanStatus           `bson:"status" json:"status"`
+	RouteIDs             []primitive.ObjectID `bson:"route_ids,omitempty" json:"route_ids,omitempty"`
+	CreatedAt            time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt            time.Time            `bson:"updated_at" json:"updated_at"`
+}
+
+// NewPlan creates a new draft plan instance
+func NewPlan(date time.Time, startLocation *Location) *Plan {
+	now := time.Now()
+	return &Plan{
+		Date:                 date,
+		StartLocation:        startLocation,
+		Routes:               make([]PlannedRoute, 0),
+		UnassignedPackageIDs: make([]primitive.ObjectID, 0),
+		Status:               PlanStatusDraft,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+// AddRoute adds a planned route and accumulates its distance into the plan total
+func (p *Plan) AddRoute(route PlannedRoute) {
+	p.Routes = append(p.Routes, route)
+	p.TotalDistanceKm += route.EstimatedDistanceKm
+	p.UpdatedAt = time.Now()
+}
+
+// Confirm marks the plan as committed and records the routes created from it
+func (p *Plan) Confirm(routeIDs []primitive.ObjectID) error {
+	if p.Status == PlanStatusConfirmed {
+		return ErrPlanAlreadyConfirmed
+	}
+
+	p.Status = PlanStatusConfirmed
+	p.RouteIDs = routeIDs
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Validate performs basic validation on the plan
+func (p *Plan) Validate() error {
+	if p.StartLocation != nil {
+		if err := p.StartLocation.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
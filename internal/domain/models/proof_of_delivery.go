@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// BlobRef points at a binary attachment kept in the blob store
+type BlobRef struct {
+	Key         string `bson:"key" json:"key"`
+	ContentType string `bson:"content_type" json:"content_type"`
+	SizeBytes   int    `bson:"size_bytes" json:"size_bytes"`
+}
+
+// ProofOfDelivery is the evidence captured by the driver when handing over a package
+type ProofOfDelivery struct {
+	RecipientName string    `bson:"recipient_name" json:"recipient_name"`
+	Location      *Location `bson:"location,omitempty" json:"location,omitempty"`
+	Signature     *BlobRef  `bson:"signature,omitempty" json:"signature,omitempty"`
+	Photo         *BlobRef  `bson:"photo,omitempty" json:"photo,omitempty"`
+	CapturedAt    time.Time `bson:"captured_at" json:"captured_at"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,35 +15,73 @@ const (
 	RouteStatusActive    RouteStatus = "active"
 	RouteStatusCompleted RouteStatus = "completed"
 	RouteStatusCancelled RouteStatus = "cancelled"
+	RouteStatusSuspended RouteStatus = "suspended"
 )
 
+// routeTransitions lists the statuses a route may move to from each status
+var routeTransitions = map[RouteStatus][]RouteStatus{
+	RouteStatusPending:   {RouteStatusActive, RouteStatusCancelled},
+	RouteStatusActive:    {RouteStatusCompleted, RouteStatusSuspended, RouteStatusCancelled},
+	RouteStatusSuspended: {RouteStatusActive, RouteStatusCancelled},
+	RouteStatusCompleted: {},
+	RouteStatusCancelled: {},
+}
+
+// CanTransitionTo reports whether the lifecycle allows moving from this status to the next one
+func (s RouteStatus) CanTransitionTo(next RouteStatus) bool {
+	for _, allowed := range routeTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValid reports whether the status is part of the route lifecycle
+func (s RouteStatus) IsValid() bool {
+	_, ok := routeTransitions[s]
+	return ok
+}
+
 // PackageRoute represents a package assigned to a route
 type PackageRoute struct {
 	PackageID         primitive.ObjectID `bson:"package_id"`
 	OrderInRoute      int                `bson:"order_in_route"`
 	Delivered         bool               `bson:"delivered"`
 	DeliveryTimestamp *time.Time         `bson:"delivery_timestamp,omitempty"`
+	PlannedArrival    *time.Time         `bson:"planned_arrival,omitempty"`
+	ProjectedArrival  *time.Time         `bson:"projected_arrival,omitempty"`
+	OutsideTimeWindow bool               `bson:"outside_time_window"`
+	Failed            bool               `bson:"failed"`
+	FailureReason     FailureReason      `bson:"failure_reason,omitempty"`
 }
 
 // Route represents a delivery route
 type Route struct {
-	ID                  primitive.ObjectID `bson:"_id,omitempty"`
-	DriverID            primitive.ObjectID `bson:"driver_id"`
-	Date                time.Time          `bson:"date"`
-	Packages            []PackageRoute     `bson:"packages"`
-	EstimatedDistanceKm float64            `bson:"estimated_distance_km"`
-	EstimatedTimeMin    int                `bson:"estimated_time_min"`
-	Status              RouteStatus        `bson:"status"`
-	CreatedAt           time.Time          `bson:"created_at"`
-	UpdatedAt           time.Time          `bson:"updated_at"`
+	ID                   primitive.ObjectID `bson:"_id,omitempty"`
+	DriverID             primitive.ObjectID `bson:"driver_id"`
+	Date                 time.Time          `bson:"date"`
+	StartLocation        *Location          `bson:"start_location,omitempty"`
+	Packages             []PackageRoute     `bson:"packages"`
+	EstimatedDistanceKm  float64            `bson:"estimated_distance_km"`
+	EstimatedTimeMin     int                `bson:"estimated_time_min"`
+	LoadWeightKg         float64            `bson:"load_weight_kg"`
+	LoadVolumeM3         float64            `bson:"load_volume_m3"`
+	WeightUtilizationPct float64            `bson:"weight_utilization_pct"`
+	VolumeUtilizationPct float64            `bson:"volume_utilization_pct"`
+	Status               RouteStatus        `bson:"status"`
+	CreatedAt            time.Time          `bson:"created_at"`
+	UpdatedAt            time.Time          `bson:"updated_at"`
+	Version              int64              `bson:"version"`
 }
 
 // NewRoute creates a new route instance
-func NewRoute(driverID primitive.ObjectID, date time.Time) *Route {
+func NewRoute(driverID primitive.ObjectID, date time.Time, startLocation *Location) *Route {
 	now := time.Now()
 	return &Route{
 		DriverID:            driverID,
 		Date:                date,
+		StartLocation:       startLocation,
 		Packages:            make([]PackageRoute, 0),
 		EstimatedDistanceKm: 0,
 		EstimatedTimeMin:    0,
@@ -62,30 +101,119 @@ func (r *Route) AddPackage(packageID primitive.ObjectID) {
 	r.UpdatedAt = time.Now()
 }
 
-// UpdatePackageStatus updates the delivery status of a package in the route
-func (r *Route) UpdatePackageStatus(packageID primitive.ObjectID, delivered bool) bool {
+// Resequence reorders the route's packages to follow the given package IDs and rewrites their
+// order numbers; packages missing from the list keep their relative order at the end of the route
+func (r *Route) Resequence(packageIDs []primitive.ObjectID) {
+	position := make(map[primitive.ObjectID]int, len(packageIDs))
+	for i, id := range packageIDs {
+		position[id] = i
+	}
+
+	ordered := make([]PackageRoute, 0, len(r.Packages))
+	var remaining []PackageRoute
+	for _, id := range packageIDs {
+		for _, p := range r.Packages {
+			if p.PackageID == id {
+				ordered = append(ordered, p)
+				break
+			}
+		}
+	}
+	for _, p := range r.Packages {
+		if _, ok := position[p.PackageID]; !ok {
+			remaining = append(remaining, p)
+		}
+	}
+	ordered = append(ordered, remaining...)
+
+	for i := range ordered {
+		ordered[i].OrderInRoute = i + 1
+	}
+	r.Packages = ordered
+	r.UpdatedAt = time.Now()
+}
+
+// Stop returns the route's stop for a package, or nil when the package is not on the route
+func (r *Route) Stop(packageID primitive.ObjectID) *PackageRoute {
 	for i := range r.Packages {
 		if r.Packages[i].PackageID == packageID {
-			r.Packages[i].Delivered = delivered
-			if delivered {
-				now := time.Now()
-				r.Packages[i].DeliveryTimestamp = &now
-			} else {
-				r.Packages[i].DeliveryTimestamp = nil
-			}
-			r.UpdatedAt = time.Now()
-			return true
+			return &r.Packages[i]
 		}
 	}
-	return false
+	return nil
 }
 
-// UpdateStatus updates the route status
-func (r *Route) UpdateStatus(status RouteStatus) error {
-	// Validate that all packages are delivered before completing the route
+// UpdatePackageStatus marks a package's stop as delivered, stamping the delivery time, or as still
+// to be delivered, clearing it
+func (r *Route) UpdatePackageStatus(packageID primitive.ObjectID, delivered bool) error {
+	stop := r.Stop(packageID)
+	if stop == nil {
+		return NotFoundf("package %s is not on this route", packageID.Hex())
+	}
+	if stop.Failed {
+		return Conflictf("package %s has already failed delivery on this route", packageID.Hex())
+	}
+	if stop.Delivered == delivered {
+		if delivered {
+			return Conflictf("package %s has already been delivered on this route", packageID.Hex())
+		}
+		return Conflictf("package %s has not been delivered on this route", packageID.Hex())
+	}
+
+	stop.Delivered = delivered
+	if delivered {
+		now := time.Now()
+		stop.DeliveryTimestamp = &now
+	} else {
+		stop.DeliveryTimestamp = nil
+	}
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateLoad records the route's cumulative load and how much of the vehicle capacity it uses,
+// failing when the load does not fit
+func (r *Route) UpdateLoad(weightKg, volumeM3 float64, capacity VehicleCapacity) error {
+	if !capacity.Fits(weightKg, volumeM3) {
+		return fmt.Errorf("%w: %.2f kg / %.3f m3 over a limit of %.2f kg / %.3f m3",
+			ErrCapacityExceeded, weightKg, volumeM3, capacity.MaxWeightKg, capacity.MaxVolumeM3)
+	}
+
+	r.LoadWeightKg = weightKg
+	r.LoadVolumeM3 = volumeM3
+	r.WeightUtilizationPct = utilizationPct(weightKg, capacity.MaxWeightKg)
+	r.VolumeUtilizationPct = utilizationPct(volumeM3, capacity.MaxVolumeM3)
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// MarkStopFailed records that the delivery attempt at a package's stop failed
+func (r *Route) MarkStopFailed(packageID primitive.ObjectID, reason FailureReason) error {
+	stop := r.Stop(packageID)
+	if stop == nil {
+		return NotFoundf("package %s is not on this route", packageID.Hex())
+	}
+	if stop.Delivered || stop.Failed {
+		return Conflictf("package %s has already been attempted on this route", packageID.Hex())
+	}
+
+	stop.Failed = true
+	stop.FailureReason = reason
+	stop.ProjectedArrival = nil
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// TransitionTo moves the route to the given status if the lifecycle allows it
+func (r *Route) TransitionTo(status RouteStatus) error {
+	if !r.Status.CanTransitionTo(status) {
+		return &TransitionError{Entity: "route", From: string(r.Status), To: string(status)}
+	}
+
+	// Validate that every stop has been delivered or attempted before completing the route
 	if status == RouteStatusCompleted {
 		for _, p := range r.Packages {
-			if !p.Delivered {
+			if !p.Delivered && !p.Failed {
 				return ErrRouteHasPendingPackages
 			}
 		}
@@ -98,6 +226,18 @@ func (r *Route) UpdateStatus(status RouteStatus) error {
 
 // Validate performs basic validation on the route
 func (r *Route) Validate() error {
-	// TODO: Implement validation logic
+	if r.StartLocation != nil {
+		if err := r.StartLocation.Validate(); err != nil {
+			return err
+		}
+	}
+	// TODO: Implement remaining validation logic
 	return nil
 }
+
+func utilizationPct(load, limit float64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return load / limit * 100
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// TimeWindow represents the period in which a customer accepts a delivery
+type TimeWindow struct {
+	Earliest time.Time `bson:"earliest" json:"earliest"`
+	Latest   time.Time `bson:"latest" json:"latest"`
+}
+
+// Contains reports whether the given time falls inside the window
+func (w TimeWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Earliest) && !t.After(w.Latest)
+}
+
+// Validate checks that the window is not empty or reversed
+func (w TimeWindow) Validate() error {
+	if w.Earliest.IsZero() || w.Latest.IsZero() {
+		return Validationf("invalid delivery window: earliest and latest are required")
+	}
+	if !w.Latest.After(w.Earliest) {
+		return Validationf("invalid delivery window: latest must be after earliest")
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package optimization
+
+import (
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// Vehicle represents a vehicle that can serve stops in a fleet problem
+type Vehicle struct {
+	ID       primitive.ObjectID
+	Capacity models.VehicleCapacity
+	SpeedKmh float64
+}
+
+// VehicleTour holds the stops assigned to a vehicle and the load they add up to
+type VehicleTour struct {
+	Vehicle  Vehicle
+	Tour     Tour
+	WeightKg float64
+	VolumeM3 float64
+}
+
+// FleetSolution is the result of distributing stops across a fleet
+type FleetSolution struct {
+	Tours      []VehicleTour
+	Unassigned []int
+}
+
+// FleetPlanner distributes the stops of a problem across several capacitated vehicles
+type FleetPlanner struct {
+	Optimizer Optimizer
+}
+
+// NewFleetPlanner creates a fleet planner that sequences each vehicle's stops with the given optimizer
+func NewFleetPlanner(optimizer Optimizer) *FleetPlanner {
+	return &FleetPlanner{
+		Optimizer: optimizer,
+	}
+}
+
+// Plan assigns every stop to the vehicle and position where it adds the least distance without
+// exceeding the vehicle's capacity, then re-sequences each vehicle's tour. Stops that fit in no
+// vehicle are reported as unassigned.
+func (f *FleetPlanner) Plan(problem *Problem, vehicles []Vehicle) FleetSolution {
+	solution := FleetSolution{
+		Tours: make([]VehicleTour, len(vehicles)),
+	}
+	for i, vehicle := range vehicles {
+		solution.Tours[i] = VehicleTour{Vehicle: vehicle, Tour: Tour{}}
+	}
+
+	// Insert the farthest stops first so that the nearby ones fill the gaps between them
+	order := problem.IdentityTour()
+	sort.SliceStable(order, func(i, j int) bool {
+		return problem.Leg(-1, order[i]) > problem.Leg(-1, order[j])
+	})
+
+	for _, stop := range order {
+		bestVehicle, bestPosition := -1, -1
+		bestCost := 0.0
+
+		for v := range solution.Tours {
+			tour := &solution.Tours[v]
+			weight := tour.WeightKg + problem.Stops[stop].WeightKg
+			volume := tour.VolumeM3 + problem.Stops[stop].VolumeM3
+			if !tour.Vehicle.Capacity.Fits(weight, volume) {
+				continue
+			}
+
+			for position := 0; position <= len(tour.Tour); position++ {
+				cost := insertionCost(problem, tour.Tour, stop, position)
+				if bestVehicle == -1 || cost < bestCost-epsilon {
+					bestVehicle, bestPosition, bestCost = v, position, cost
+				}
+			}
+		}
+
+		if bestVehicle == -1 {
+			solution.Unassigned = append(solution.Unassigned, stop)
+			continue
+		}
+
+		tour := &solution.Tours[bestVehicle]
+		tour.Tour = insertSegment(tour.Tour, Tour{stop}, bestPosition, false)
+		tour.WeightKg += problem.Stops[stop].WeightKg
+		tour.VolumeM3 += problem.Stops[stop].VolumeM3
+	}
+
+	if f.Optimizer != nil {
+		for i := range solution.Tours {
+			solution.Tours[i].Tour = f.sequence(problem, solution.Tours[i])
+		}
+	}
+
+	return solution
+}
+
+// sequence optimizes the order of a single vehicle's stops at the vehicle's own speed
+func (f *FleetPlanner) sequence(problem *Problem, vehicleTour VehicleTour) Tour {
+	tour := vehicleTour.Tour
+	if len(tour) < 2 {
+		return tour
+	}
+
+	stops := make([]Stop, len(tour))
+	for i, index := range tour {
+		stops[i] = problem.Stops[index]
+	}
+
+	subProblem := NewProblem(problem.Start, stops)
+	subProblem.Departure = problem.Departure
+	subProblem.SpeedKmh = vehicleTour.Vehicle.SpeedKmh
+
+	subTour := f.Optimizer.Optimize(subProblem)
+
+	sequenced := make(Tour, len(subTour))
+	for i, index := range subTour {
+		sequenced[i] = tour[index]
+	}
+	return sequenced
+}
+
+// insertionCost returns the extra distance of visiting stop at the given position of the tour
+func insertionCost(problem *Problem, tour Tour, stop, position int) float64 {
+	previous := -1
+	if position > 0 {
+		previous = tour[position-1]
+	}
+
+	cost := problem.Leg(previous, stop)
+	if position < len(tour) {
+		next := tour[position]
+		cost += problem.Leg(stop, next) - problem.Leg(previous, next)
+	}
+	return cost
+}
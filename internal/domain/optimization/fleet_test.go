@@ -0,0 +1,137 @@
+package optimization
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+func loadedStopAt(latitude, longitude, weightKg float64) Stop {
+	stop := stopAt(latitude, longitude)
+	stop.WeightKg = weightKg
+	stop.VolumeM3 = 0.01
+	return stop
+}
+
+func vehicleWith(maxWeightKg float64) Vehicle {
+	return Vehicle{
+		ID:       primitive.NewObjectID(),
+		Capacity: models.VehicleCapacity{MaxWeightKg: maxWeightKg, MaxVolumeM3: 1},
+		SpeedKmh: 30,
+	}
+}
+
+// wantLoads fails the test unless every tour's load matches its stops and fits its vehicle, and
+// every stop is either in exactly one tour or unassigned
+func wantLoads(t *testing.T, problem *Problem, solution FleetSolution) {
+	t.Helper()
+
+	seen := make(map[int]bool)
+	visit := func(stop int) {
+		if seen[stop] {
+			t.Fatalf("stop %d is planned twice in %+v", stop, solution)
+		}
+		seen[stop] = true
+	}
+
+	for _, tour := range solution.Tours {
+		weight, volume := 0.0, 0.0
+		for _, stop := range tour.Tour {
+			visit(stop)
+			weight += problem.Stops[stop].WeightKg
+			volume += problem.Stops[stop].VolumeM3
+		}
+		if weight != tour.WeightKg || volume != tour.VolumeM3 {
+			t.Errorf("tour %v loads %.2f kg and %.2f m3, want %.2f kg and %.2f m3", tour.Tour, tour.WeightKg, tour.VolumeM3, weight, volume)
+		}
+		if !tour.Vehicle.Capacity.Fits(weight, volume) {
+			t.Errorf("tour %v loads %.2f kg, over the vehicle's %.2f kg", tour.Tour, weight, tour.Vehicle.Capacity.MaxWeightKg)
+		}
+	}
+	for _, stop := range solution.Unassigned {
+		visit(stop)
+	}
+	if len(seen) != len(problem.Stops) {
+		t.Errorf("solution covers %d stops, want %d", len(seen), len(problem.Stops))
+	}
+}
+
+func TestFleetPlanner_Plan(t *testing.T) {
+	tests := []struct {
+		name           string
+		stops          []Stop
+		vehicles       []Vehicle
+		wantTourSizes  []int
+		wantUnassigned []int
+	}{
+		{
+			name:          "everything fits one vehicle",
+			stops:         []Stop{loadedStopAt(0, 0.01, 5), loadedStopAt(0, 0.02, 5), loadedStopAt(0, 0.03, 5)},
+			vehicles:      []Vehicle{vehicleWith(20), vehicleWith(20)},
+			wantTourSizes: []int{3, 0},
+		},
+		{
+			name:          "load split across vehicles",
+			stops:         []Stop{loadedStopAt(0, 0.01, 8), loadedStopAt(0, 0.02, 8), loadedStopAt(0, 0.03, 8)},
+			vehicles:      []Vehicle{vehicleWith(20), vehicleWith(20)},
+			wantTourSizes: []int{2, 1},
+		},
+		{
+			name:           "oversize stop left unassigned",
+			stops:          []Stop{loadedStopAt(0, 0.01, 5), loadedStopAt(0, 0.02, 50)},
+			vehicles:       []Vehicle{vehicleWith(20), vehicleWith(20)},
+			wantTourSizes:  []int{1, 0},
+			wantUnassigned: []int{1},
+		},
+		{
+			name:           "no vehicles",
+			stops:          []Stop{loadedStopAt(0, 0.01, 5)},
+			wantTourSizes:  []int{},
+			wantUnassigned: []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := NewProblem(origin, tt.stops)
+
+			solution := NewFleetPlanner(NewDefaultOptimizer()).Plan(problem, tt.vehicles)
+			wantLoads(t, problem, solution)
+
+			sizes := make([]int, len(solution.Tours))
+			for i, tour := range solution.Tours {
+				sizes[i] = len(tour.Tour)
+				if tour.Vehicle.ID != tt.vehicles[i].ID {
+					t.Errorf("tour %d is for vehicle %s, want %s", i, tour.Vehicle.ID.Hex(), tt.vehicles[i].ID.Hex())
+				}
+			}
+			if !reflect.DeepEqual(sizes, tt.wantTourSizes) {
+				t.Errorf("tour sizes = %v, want %v", sizes, tt.wantTourSizes)
+			}
+			if !reflect.DeepEqual(solution.Unassigned, tt.wantUnassigned) {
+				t.Errorf("Unassigned = %v, want %v", solution.Unassigned, tt.wantUnassigned)
+			}
+		})
+	}
+}
+
+func TestFleetPlanner_PlanIsDeterministic(t *testing.T) {
+	// Stops at the same distance from the start tie on insertion order and cost
+	stops := []Stop{
+		loadedStopAt(0, 0.01, 6), loadedStopAt(0.01, 0, 6), loadedStopAt(0, -0.01, 6), loadedStopAt(-0.01, 0, 6),
+		loadedStopAt(0, 0.02, 6), loadedStopAt(0.02, 0, 6), loadedStopAt(0, -0.02, 6), loadedStopAt(-0.02, 0, 6),
+	}
+	vehicles := []Vehicle{vehicleWith(20), vehicleWith(20), vehicleWith(20)}
+	planner := NewFleetPlanner(NewDefaultOptimizer())
+
+	want := planner.Plan(NewProblem(origin, stops), vehicles)
+	wantLoads(t, NewProblem(origin, stops), want)
+	for i := 0; i < 10; i++ {
+		if got := planner.Plan(NewProblem(origin, stops), vehicles); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Plan = %+v, want the same solution as the first run %+v", got, want)
+		}
+	}
+}
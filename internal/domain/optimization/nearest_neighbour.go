@@ -0,0 +1,39 @@
+package optimization
+
+// NearestNeighbour builds a tour by always driving to the closest unvisited stop
+type NearestNeighbour struct{}
+
+// Construct builds a tour starting at the problem's start point, or at the first stop when there is none
+func (NearestNeighbour) Construct(problem *Problem) Tour {
+	n := len(problem.Stops)
+	tour := make(Tour, 0, n)
+	if n == 0 {
+		return tour
+	}
+
+	visited := make([]bool, n)
+	current := -1
+	if problem.Start == nil {
+		current = 0
+		visited[0] = true
+		tour = append(tour, 0)
+	}
+
+	for len(tour) < n {
+		next := -1
+		for candidate := 0; candidate < n; candidate++ {
+			if visited[candidate] {
+				continue
+			}
+			if next == -1 || problem.Leg(current, candidate) < problem.Leg(current, next) {
+				next = candidate
+			}
+		}
+
+		visited[next] = true
+		tour = append(tour, next)
+		current = next
+	}
+
+	return tour
+}
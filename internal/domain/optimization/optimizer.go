@@ -0,0 +1,219 @@
+package optimization
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// epsilon is the minimum cost reduction for a move to count as an improvement
+const epsilon = 1e-9
+
+// latenessPenaltyKmPerMin is how many kilometers of detour one minute of late arrival is worth,
+// high enough that improvers only trade punctuality for distance as a last resort
+const latenessPenaltyKmPerMin = 10.0
+
+// Stop represents a location that has to be visited on a route
+type Stop struct {
+	ID       primitive.ObjectID
+	Location models.Location
+	WeightKg float64
+	VolumeM3 float64
+
+	// Window and ServiceDuration are only honoured when the problem has a departure time
+	Window          *models.TimeWindow
+	ServiceDuration time.Duration
+}
+
+// Tour is a visiting order expressed as indexes into Problem.Stops
+type Tour []int
+
+// Problem describes a single vehicle stop sequencing problem. Setting Departure and SpeedKmh
+// makes the cost account for the stops' delivery windows.
+type Problem struct {
+	Start     *models.Location
+	Stops     []Stop
+	Departure time.Time
+	SpeedKmh  float64
+	distances [][]float64
+}
+
+// NewProblem creates a problem and precomputes the distances between all its points
+func NewProblem(start *models.Location, stops []Stop) *Problem {
+	p := &Problem{
+		Start: start,
+		Stops: stops,
+	}
+
+	// Row and column 0 hold the start point; stop i lives at index i+1
+	n := len(stops) + 1
+	p.distances = make([][]float64, n)
+	for i := range p.distances {
+		p.distances[i] = make([]float64, n)
+	}
+	for i := 0; i < len(stops); i++ {
+		if start != nil {
+			d := start.DistanceKm(stops[i].Location)
+			p.distances[0][i+1] = d
+			p.distances[i+1][0] = d
+		}
+		for j := i + 1; j < len(stops); j++ {
+			d := stops[i].Location.DistanceKm(stops[j].Location)
+			p.distances[i+1][j+1] = d
+			p.distances[j+1][i+1] = d
+		}
+	}
+
+	return p
+}
+
+// Leg returns the distance between two stops; a from index of -1 denotes the start point
+func (p *Problem) Leg(from, to int) float64 {
+	return p.distances[from+1][to+1]
+}
+
+// Distance returns the length of the tour in kilometers, without returning to the start
+func (p *Problem) Distance(tour Tour) float64 {
+	var distance float64
+	previous := -1
+	for _, stop := range tour {
+		distance += p.Leg(previous, stop)
+		previous = stop
+	}
+	return distance
+}
+
+// Cost returns the value improvers try to minimize for a tour: its distance plus a penalty
+// for every minute a stop is reached after its delivery window closes
+func (p *Problem) Cost(tour Tour) float64 {
+	return p.Distance(tour) + latenessPenaltyKmPerMin*p.LatenessMin(tour)
+}
+
+// LatenessMin returns the total minutes by which the tour misses the stops' delivery windows.
+// Arriving early is not late: the driver waits for the window to open.
+func (p *Problem) LatenessMin(tour Tour) float64 {
+	if p.Departure.IsZero() || p.SpeedKmh <= 0 {
+		return 0
+	}
+
+	var lateness float64
+	clock := p.Departure
+	previous := -1
+	for _, index := range tour {
+		stop := p.Stops[index]
+		clock = clock.Add(travelTime(p.Leg(previous, index), p.SpeedKmh))
+
+		if stop.Window != nil {
+			if clock.Before(stop.Window.Earliest) {
+				clock = stop.Window.Earliest
+			} else if clock.After(stop.Window.Latest) {
+				lateness += clock.Sub(stop.Window.Latest).Minutes()
+			}
+		}
+
+		clock = clock.Add(stop.ServiceDuration)
+		previous = index
+	}
+
+	return lateness
+}
+
+// IdentityTour returns the tour that visits the stops in their given order
+func (p *Problem) IdentityTour() Tour {
+	tour := make(Tour, len(p.Stops))
+	for i := range tour {
+		tour[i] = i
+	}
+	return tour
+}
+
+// Optimizer orders the stops of a problem
+type Optimizer interface {
+	Optimize(problem *Problem) Tour
+}
+
+// Constructor builds an initial tour from scratch
+type Constructor interface {
+	Construct(problem *Problem) Tour
+}
+
+// Improver refines an existing tour
+type Improver interface {
+	Improve(problem *Problem, tour Tour) Tour
+}
+
+// Pipeline is an Optimizer that builds a tour and then refines it with a chain of improvers
+type Pipeline struct {
+	Constructor Constructor
+	Improvers   []Improver
+}
+
+// NewDefaultOptimizer creates a nearest neighbour construction followed by 2-opt and Or-opt
+func NewDefaultOptimizer() *Pipeline {
+	return &Pipeline{
+		Constructor: NearestNeighbour{},
+		Improvers:   []Improver{TwoOpt{}, OrOpt{}},
+	}
+}
+
+// Constructors and Improvers name the algorithms an optimizer can be assembled from
+var (
+	Constructors = map[string]Constructor{
+		"nearest_neighbour": NearestNeighbour{},
+	}
+	Improvers = map[string]Improver{
+		"two_opt": TwoOpt{},
+		"or_opt":  OrOpt{},
+	}
+)
+
+// NewOptimizer creates a pipeline from named algorithms, applying the improvers in the given order;
+// an empty constructor name only improves the order the stops were given in
+func NewOptimizer(constructor string, improvers []string) (*Pipeline, error) {
+	pipeline := &Pipeline{}
+	if constructor != "" {
+		c, ok := Constructors[constructor]
+		if !ok {
+			return nil, fmt.Errorf("unknown tour constructor %q", constructor)
+		}
+		pipeline.Constructor = c
+	}
+	for _, name := range improvers {
+		improver, ok := Improvers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown tour improver %q", name)
+		}
+		pipeline.Improvers = append(pipeline.Improvers, improver)
+	}
+	return pipeline, nil
+}
+
+// Optimize improves both the constructed tour and the current order and returns the cheapest,
+// so the result is never worse than the order the stops were given in
+func (o *Pipeline) Optimize(problem *Problem) Tour {
+	candidates := []Tour{problem.IdentityTour()}
+	if o.Constructor != nil {
+		candidates = append(candidates, o.Constructor.Construct(problem))
+	}
+
+	var best Tour
+	bestCost := 0.0
+	for _, tour := range candidates {
+		for _, improver := range o.Improvers {
+			tour = improver.Improve(problem, tour)
+		}
+		if cost := problem.Cost(tour); best == nil || cost < bestCost-epsilon {
+			best = tour
+			bestCost = cost
+		}
+	}
+
+	return best
+}
+
+func travelTime(distanceKm, speedKmh float64) time.Duration {
+	return time.Duration(distanceKm / speedKmh * float64(time.Hour))
+}
@@ -0,0 +1,237 @@
+package optimization
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// origin is the start point of the test problems; 0.01 degrees around it are about 1.1 km
+var origin = &models.Location{Latitude: 0, Longitude: 0}
+
+// testDeparture is the departure time of the problems with delivery windows
+var testDeparture = time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+
+func stopAt(latitude, longitude float64) Stop {
+	return Stop{Location: models.Location{Latitude: latitude, Longitude: longitude}}
+}
+
+// optimizers lists every way the service can sequence stops
+func optimizers() map[string]Optimizer {
+	return map[string]Optimizer{
+		"default":           NewDefaultOptimizer(),
+		"nearest neighbour": &Pipeline{Constructor: NearestNeighbour{}},
+		"two-opt":           &Pipeline{Improvers: []Improver{TwoOpt{}}},
+		"or-opt":            &Pipeline{Improvers: []Improver{OrOpt{}}},
+	}
+}
+
+// bruteForceCost returns the cost of the cheapest tour of a small problem
+func bruteForceCost(problem *Problem) float64 {
+	best := math.Inf(1)
+	var permute func(tour Tour, k int)
+	permute = func(tour Tour, k int) {
+		if k == len(tour) {
+			best = math.Min(best, problem.Cost(tour))
+			return
+		}
+		for i := k; i < len(tour); i++ {
+			tour[k], tour[i] = tour[i], tour[k]
+			permute(tour, k+1)
+			tour[k], tour[i] = tour[i], tour[k]
+		}
+	}
+	permute(problem.IdentityTour(), 0)
+	return best
+}
+
+// wantPermutation fails the test unless tour visits every stop of the problem exactly once
+func wantPermutation(t *testing.T, problem *Problem, tour Tour) {
+	t.Helper()
+
+	seen := make([]bool, len(problem.Stops))
+	for _, index := range tour {
+		if index < 0 || index >= len(seen) || seen[index] {
+			t.Fatalf("tour = %v, want a permutation of %d stops", tour, len(seen))
+		}
+		seen[index] = true
+	}
+	if len(tour) != len(seen) {
+		t.Fatalf("tour = %v, want a permutation of %d stops", tour, len(seen))
+	}
+}
+
+func TestOptimize_SmallProblems(t *testing.T) {
+	tests := []struct {
+		name  string
+		start *models.Location
+		stops []Stop
+		want  Tour
+	}{
+		{name: "no stops", start: origin, want: Tour{}},
+		{name: "one stop", start: origin, stops: []Stop{stopAt(0, 0.01)}, want: Tour{0}},
+		{name: "two stops, nearest last", start: origin, stops: []Stop{stopAt(0, 0.02), stopAt(0, 0.01)}, want: Tour{1, 0}},
+		{name: "two stops without a start", stops: []Stop{stopAt(0, 0.02), stopAt(0, 0.01)}, want: Tour{0, 1}},
+	}
+
+	for _, tt := range tests {
+		for name, optimizer := range optimizers() {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				problem := NewProblem(tt.start, tt.stops)
+
+				tour := optimizer.Optimize(problem)
+				wantPermutation(t, problem, tour)
+				if math.Abs(problem.Cost(tour)-problem.Cost(tt.want)) > epsilon {
+					t.Errorf("tour = %v costing %.3f, want %v costing %.3f", tour, problem.Cost(tour), tt.want, problem.Cost(tt.want))
+				}
+			})
+		}
+	}
+}
+
+func TestOptimize_KnownOptimalSquare(t *testing.T) {
+	// The corners of a square, listed so that the given order crosses it diagonally twice
+	stops := []Stop{stopAt(0, 0.01), stopAt(0.01, 0), stopAt(0.01, 0.01), stopAt(0, 0)}
+	start := &models.Location{Latitude: 0, Longitude: -0.01}
+
+	for name, optimizer := range optimizers() {
+		t.Run(name, func(t *testing.T) {
+			problem := NewProblem(start, stops)
+			optimal := bruteForceCost(problem)
+
+			tour := optimizer.Optimize(problem)
+			wantPermutation(t, problem, tour)
+			if name != "nearest neighbour" && problem.Cost(tour) > optimal+epsilon {
+				t.Errorf("tour = %v costing %.3f, want the optimum %.3f", tour, problem.Cost(tour), optimal)
+			}
+			// Every optimal tour enters at the corner next to the start and walks around the square
+			if name != "nearest neighbour" && tour[0] != 3 {
+				t.Errorf("tour = %v, want it to start at the nearest corner 3", tour)
+			}
+		})
+	}
+}
+
+func TestImprovers_ReverseCrossing(t *testing.T) {
+	// Four stops on a line east of the start, with the middle two visited backwards
+	problem := NewProblem(origin, []Stop{stopAt(0, 0.01), stopAt(0, 0.02), stopAt(0, 0.03), stopAt(0, 0.04)})
+	crossing := Tour{0, 2, 1, 3}
+
+	tests := []struct {
+		name     string
+		improver Improver
+	}{
+		{name: "two-opt", improver: TwoOpt{}},
+		{name: "or-opt", improver: OrOpt{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append(Tour(nil), crossing...)
+
+			tour := tt.improver.Improve(problem, input)
+			if want := (Tour{0, 1, 2, 3}); !reflect.DeepEqual(tour, want) {
+				t.Errorf("Improve(%v) = %v, want %v", crossing, tour, want)
+			}
+			if !reflect.DeepEqual(input, crossing) {
+				t.Errorf("Improve modified its input to %v", input)
+			}
+		})
+	}
+}
+
+// reversedConstructor builds the tour visiting the stops backwards, to test the pipeline's safeguard
+type reversedConstructor struct{}
+
+func (reversedConstructor) Construct(problem *Problem) Tour {
+	tour := problem.IdentityTour()
+	reverse(tour)
+	return tour
+}
+
+func TestOptimize_NeverWorseThanInputOrder(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		stops := make([]Stop, 3+random.Intn(6))
+		for j := range stops {
+			stops[j] = stopAt(random.Float64()*0.1, random.Float64()*0.1)
+		}
+		problem := NewProblem(origin, stops)
+		inputCost := problem.Cost(problem.IdentityTour())
+
+		optimizers := optimizers()
+		optimizers["reversed constructor"] = &Pipeline{Constructor: reversedConstructor{}}
+		for name, optimizer := range optimizers {
+			tour := optimizer.Optimize(problem)
+			wantPermutation(t, problem, tour)
+			if cost := problem.Cost(tour); cost > inputCost+epsilon {
+				t.Errorf("problem %d: %s tour costs %.3f, more than the input order's %.3f", i, name, cost, inputCost)
+			}
+		}
+	}
+}
+
+func TestOptimize_TimeWindows(t *testing.T) {
+	// The nearby stop east is the shorter first visit, but the stop west closes three minutes after
+	// departure and is only reached in time when visited first
+	east := stopAt(0, 0.005)
+	west := stopAt(0, -0.02)
+	west.Window = &models.TimeWindow{Earliest: testDeparture, Latest: testDeparture.Add(3 * time.Minute)}
+
+	tests := []struct {
+		name      string
+		departure time.Time
+		want      Tour
+	}{
+		{name: "windows honoured with a departure", departure: testDeparture, want: Tour{1, 0}},
+		{name: "windows ignored without a departure", want: Tour{0, 1}},
+	}
+
+	for _, tt := range tests {
+		for name, optimizer := range optimizers() {
+			if name == "nearest neighbour" {
+				continue
+			}
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				problem := NewProblem(origin, []Stop{east, west})
+				problem.Departure = tt.departure
+				problem.SpeedKmh = 60
+
+				tour := optimizer.Optimize(problem)
+				if !reflect.DeepEqual(tour, tt.want) {
+					t.Errorf("tour = %v, want %v", tour, tt.want)
+				}
+				if !tt.departure.IsZero() && problem.LatenessMin(tour) != 0 {
+					t.Errorf("tour %v is %.1f minutes late, want it on time", tour, problem.LatenessMin(tour))
+				}
+			})
+		}
+	}
+}
+
+func TestProblem_LatenessMin(t *testing.T) {
+	late := stopAt(0, 0.01)
+	late.Window = &models.TimeWindow{Earliest: testDeparture, Latest: testDeparture}
+	waiting := stopAt(0, 0.02)
+	waiting.Window = &models.TimeWindow{Earliest: testDeparture.Add(time.Hour), Latest: testDeparture.Add(2 * time.Hour)}
+	waiting.ServiceDuration = 5 * time.Minute
+	afterWaiting := stopAt(0, 0.03)
+	afterWaiting.Window = &models.TimeWindow{Earliest: testDeparture, Latest: testDeparture.Add(time.Hour)}
+
+	problem := NewProblem(origin, []Stop{late, waiting, afterWaiting})
+	problem.Departure = testDeparture
+	problem.SpeedKmh = 60
+
+	// About 1.1 km at 60 km/h reaches the first stop 1.1 minutes after its window closed; waiting
+	// for the second window and serving it makes the third stop over five minutes late
+	legMin := problem.Leg(-1, 0)
+	want := legMin + (5 + legMin)
+	if got := problem.LatenessMin(Tour{0, 1, 2}); math.Abs(got-want) > 1e-6 {
+		t.Errorf("LatenessMin = %.3f, want %.3f", got, want)
+	}
+}
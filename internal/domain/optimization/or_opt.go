@@ -0,0 +1,57 @@
+package optimization
+
+// maxOrOptSegment is the longest chain of consecutive stops Or-opt tries to relocate
+const maxOrOptSegment = 3
+
+// OrOpt improves a tour by moving short chains of consecutive stops to a better position
+type OrOpt struct{}
+
+// Improve relocates segments of one to three stops, optionally reversed, while that lowers the cost
+func (OrOpt) Improve(problem *Problem, tour Tour) Tour {
+	best := append(Tour(nil), tour...)
+	bestCost := problem.Cost(best)
+
+	for {
+		candidate, cost, ok := firstImprovingRelocation(problem, best, bestCost)
+		if !ok {
+			return best
+		}
+		best, bestCost = candidate, cost
+	}
+}
+
+// firstImprovingRelocation returns the first segment move that is cheaper than the current cost
+func firstImprovingRelocation(problem *Problem, tour Tour, currentCost float64) (Tour, float64, bool) {
+	for length := 1; length <= maxOrOptSegment && length < len(tour); length++ {
+		for i := 0; i+length <= len(tour); i++ {
+			segment := tour[i : i+length]
+			rest := append(append(Tour(nil), tour[:i]...), tour[i+length:]...)
+
+			for j := 0; j <= len(rest); j++ {
+				for _, reversed := range []bool{false, true} {
+					if j == i && !reversed {
+						continue
+					}
+					candidate := insertSegment(rest, segment, j, reversed)
+					if cost := problem.Cost(candidate); cost < currentCost-epsilon {
+						return candidate, cost, true
+					}
+				}
+			}
+		}
+	}
+	return nil, 0, false
+}
+
+func insertSegment(rest, segment Tour, position int, reversed bool) Tour {
+	candidate := make(Tour, 0, len(rest)+len(segment))
+	candidate = append(candidate, rest[:position]...)
+	if reversed {
+		for k := len(segment) - 1; k >= 0; k-- {
+			candidate = append(candidate, segment[k])
+		}
+	} else {
+		candidate = append(candidate, segment...)
+	}
+	return append(candidate, rest[position:]...)
+}
@@ -0,0 +1,35 @@
+package optimization
+
+// TwoOpt improves a tour by reversing segments until no reversal lowers its cost
+type TwoOpt struct{}
+
+// Improve applies improving segment reversals to the tour
+func (TwoOpt) Improve(problem *Problem, tour Tour) Tour {
+	best := append(Tour(nil), tour...)
+	bestCost := problem.Cost(best)
+	candidate := make(Tour, len(best))
+
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(best)-1; i++ {
+			for j := i + 1; j < len(best); j++ {
+				copy(candidate, best)
+				reverse(candidate[i : j+1])
+
+				if cost := problem.Cost(candidate); cost < bestCost-epsilon {
+					copy(best, candidate)
+					bestCost = cost
+					improved = true
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+func reverse(tour Tour) {
+	for i, j := 0, len(tour)-1; i < j; i, j = i+1, j-1 {
+		tour[i], tour[j] = tour[j], tour[i]
+	}
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import "github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+
+// ErrNotFound is returned when no entity matches the requested ID or key
+var ErrNotFound = models.ErrNotFound
+
+// NotFound builds the error returned when no entity of the given kind matches key
+func NotFound(entity, key string) error {
+	return models.NotFoundf("%s %s not found", entity, key)
+}
+
+// StaleVersion builds the error returned when an update is based on an outdated version of an entity
+func StaleVersion(entity, key string, version int64) error {
+	return models.Conflictf("%s %s was modified concurrently: version %d is outdated", entity, key, version)
+}
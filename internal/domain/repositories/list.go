@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+const (
+	// DefaultPageSize is the number of entities listed when a request sets no limit
+	DefaultPageSize = 50
+
+	// MaxPageSize bounds the number of entities listed in a single page
+	MaxPageSize = 200
+)
+
+// PageRequest selects a window of a listing ordered by creation: the entities after the cursor, up to the limit
+type PageRequest struct {
+	Cursor     string
+	Limit      int
+	Descending bool
+}
+
+// Size returns the number of entities to list, applying the default and the maximum page size
+func (p PageRequest) Size() int {
+	switch {
+	case p.Limit <= 0:
+		return DefaultPageSize
+	case p.Limit > MaxPageSize:
+		return MaxPageSize
+	default:
+		return p.Limit
+	}
+}
+
+// After decodes the cursor into the ID the listing resumes after, the zero ID for the first page
+func (p PageRequest) After() (primitive.ObjectID, error) {
+	if p.Cursor == "" {
+		return primitive.NilObjectID, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil || len(data) != len(primitive.ObjectID{}) {
+		return primitive.NilObjectID, models.Validationf("invalid page cursor %q", p.Cursor)
+	}
+
+	var id primitive.ObjectID
+	copy(id[:], data)
+	return id, nil
+}
+
+// Page is one window of a listing, with the cursor to request the next one
+type Page[T any] struct {
+	Items      []*T   `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// NewPage builds a page from the entities fetched for req, which include one extra entity
+// when the listing continues past this page
+func NewPage[T any](items []*T, req PageRequest, id func(*T) primitive.ObjectID) *Page[T] {
+	page := &Page[T]{Items: items}
+	if page.Items == nil {
+		page.Items = []*T{}
+	}
+
+	if len(items) > req.Size() {
+		page.Items = items[:req.Size()]
+		last := id(page.Items[len(page.Items)-1])
+		page.NextCursor = base64.RawURLEncoding.EncodeToString(last[:])
+	}
+	return page
+}
+
+// DriverFilter narrows a driver listing; zero fields match every driver
+type DriverFilter struct {
+	VehicleType models.VehicleType
+	Active      *bool
+}
+
+// PackageFilter narrows a package listing; zero fields match every package
+type PackageFilter struct {
+	Status         models.PackageStatus
+	TrackingPrefix string
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+}
+
+// RouteFilter narrows a route listing; zero fields match every route. Delivered selects routes
+// with stops, none of them left to attempt (true), or that still have a stop neither delivered nor
+// failed (false); routes without stops match neither.
+type RouteFilter struct {
+	Status    models.RouteStatus
+	DriverID  primitive.ObjectID
+	DateFrom  time.Time
+	DateTo    time.Time
+	Delivered *bool
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// PackageEventRepository is the append-only store of package tracking events
+type PackageEventRepository interface {
+	Append(ctx context.Context, event *models.PackageEvent) error
+	ListByPackageID(ctx context.Context, packageID primitive.ObjectID) ([]*models.PackageEvent, error)
+}
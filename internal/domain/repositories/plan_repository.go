@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// PlanRepository persists fleet plans
+type PlanRepository interface {
+	Create(ctx context.Context, plan *models.Plan) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.Plan, error)
+	Update(ctx context.Context, plan *models.Plan) error
+}
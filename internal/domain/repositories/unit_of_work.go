@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+// UnitOfWork makes the writes of a use case atomic: either all of them persist or none does
+type UnitOfWork interface {
+	// Do runs fn as a single unit; repositories called with the context passed to fn take part
+	// in it. fn may run more than once when the backend retries a transient conflict.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
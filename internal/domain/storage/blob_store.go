@@ -0,0 +1,15 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under the requested key
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores opaque binary objects, such as delivery signatures and photos, under slash-separated keys
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
@@ -0,0 +1,150 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout bounds the readiness checks when the caller sets no earlier deadline
+const checkTimeout = 2 * time.Second
+
+// Readiness statuses
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+	StatusDraining    = "draining"
+)
+
+// Check reports an error when a dependency the server needs to take traffic is not usable
+type Check func(ctx context.Context) error
+
+// Report is the outcome of the readiness checks, with the result of each check by name
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Ready reports whether the server can take traffic
+func (r Report) Ready() bool {
+	return r.Status == StatusOK
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker evaluates the readiness of the server from its dependency checks, and publishes it to
+// the gRPC health service. Once draining, the server reports not ready for good.
+type Checker struct {
+	grpcHealth *grpchealth.Server
+	interval   time.Duration
+	checks     []namedCheck
+	draining   atomic.Bool
+
+	// published is the last status set in the gRPC health service, only touched by Run
+	published healthpb.HealthCheckResponse_ServingStatus
+}
+
+// NewChecker creates a checker publishing the readiness to grpcHealth every interval. The server
+// reports not serving until the first checks pass.
+func NewChecker(grpcHealth *grpchealth.Server, interval time.Duration) *Checker {
+	grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Checker{
+		grpcHealth: grpcHealth,
+		interval:   interval,
+	}
+}
+
+// AddCheck registers a dependency check; checks must be added before the checker is used
+func (c *Checker) AddCheck(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Drain reports the server not ready from now on, so that load balancers stop routing to it
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+	c.grpcHealth.Shutdown()
+}
+
+// Ready runs every check concurrently and reports the server ready when they all pass
+func (c *Checker) Ready(ctx context.Context) Report {
+	if c.draining.Load() {
+		return Report{Status: StatusDraining}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	results := make([]error, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check.check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]string, len(c.checks))}
+	for i, check := range c.checks {
+		if results[i] != nil {
+			report.Status = StatusUnavailable
+			report.Checks[check.name] = results[i].Error()
+			continue
+		}
+		report.Checks[check.name] = StatusOK
+	}
+	return report
+}
+
+// Run publishes the readiness to the gRPC health service right away and then every interval
+// until ctx is done, when it drains
+func (c *Checker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.publish(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("reporting not ready while draining")
+			c.Drain()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish sets the serving status of the whole server in the gRPC health service, logging the
+// changes
+func (c *Checker) publish(ctx context.Context) {
+	report := c.Ready(ctx)
+	if report.Status == StatusDraining || ctx.Err() != nil {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !report.Ready() {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	if status == c.published {
+		return
+	}
+	c.published = status
+
+	if status == healthpb.HealthCheckResponse_SERVING {
+		slog.Info("ready")
+	} else {
+		slog.Warn("not ready", slog.Any("checks", report.Checks))
+	}
+	c.grpcHealth.SetServingStatus("", status)
+}
@@ -0,0 +1,163 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func passing(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestChecker_Ready(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     map[string]Check
+		drain      bool
+		wantStatus string
+		wantChecks map[string]string
+	}{
+		{
+			name:       "no checks",
+			wantStatus: StatusOK,
+			wantChecks: map[string]string{},
+		},
+		{
+			name:       "every dependency up",
+			checks:     map[string]Check{"mongodb": passing, "kpi-refresher": passing},
+			wantStatus: StatusOK,
+			wantChecks: map[string]string{"mongodb": StatusOK, "kpi-refresher": StatusOK},
+		},
+		{
+			name:       "one dependency down",
+			checks:     map[string]Check{"mongodb": failing, "kpi-refresher": passing},
+			wantStatus: StatusUnavailable,
+			wantChecks: map[string]string{"mongodb": "connection refused", "kpi-refresher": StatusOK},
+		},
+		{
+			name:       "draining",
+			checks:     map[string]Check{"mongodb": passing},
+			drain:      true,
+			wantStatus: StatusDraining,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(grpchealth.NewServer(), time.Second)
+			for name, check := range tt.checks {
+				checker.AddCheck(name, check)
+			}
+			if tt.drain {
+				checker.Drain()
+			}
+
+			report := checker.Ready(context.Background())
+			if report.Status != tt.wantStatus || report.Ready() != (tt.wantStatus == StatusOK) {
+				t.Errorf("Ready() status = %q, want %q", report.Status, tt.wantStatus)
+			}
+			if !maps.Equal(report.Checks, tt.wantChecks) {
+				t.Errorf("Ready() checks = %v, want %v", report.Checks, tt.wantChecks)
+			}
+		})
+	}
+}
+
+func TestChecker_ReadyTimeout(t *testing.T) {
+	checker := NewChecker(grpchealth.NewServer(), time.Second)
+	checker.AddCheck("mongodb", func(ctx context.Context) error {
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) > checkTimeout {
+			t.Errorf("check deadline = %v, want one within %v", deadline, checkTimeout)
+		}
+		return nil
+	})
+	checker.AddCheck("hanging", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// An earlier deadline of the caller bounds the checks instead of checkTimeout
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	report := checker.Ready(ctx)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Ready() took %v, want it bounded by the deadline", elapsed)
+	}
+	if report.Status != StatusUnavailable || report.Checks["hanging"] != context.DeadlineExceeded.Error() {
+		t.Errorf("Ready() = %+v, want the hanging check to time out", report)
+	}
+	if report.Checks["mongodb"] != StatusOK {
+		t.Errorf("Ready() = %+v, want mongodb ok", report)
+	}
+}
+
+// servingStatus returns the status the gRPC health service reports for the whole server
+func servingStatus(t *testing.T, grpcHealth *grpchealth.Server) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	response, err := grpcHealth.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	return response.Status
+}
+
+func waitServingStatus(t *testing.T, grpcHealth *grpchealth.Server, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); servingStatus(t, grpcHealth) != want; {
+		if time.Now().After(deadline) {
+			t.Fatalf("serving status = %v, want %v", servingStatus(t, grpcHealth), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestChecker_Run(t *testing.T) {
+	grpcHealth := grpchealth.NewServer()
+	checker := NewChecker(grpcHealth, 10*time.Millisecond)
+	var down atomic.Bool
+	checker.AddCheck("mongodb", func(context.Context) error {
+		if down.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	if got := servingStatus(t, grpcHealth); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("serving status before the first check = %v, want NOT_SERVING", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- checker.Run(ctx)
+	}()
+
+	waitServingStatus(t, grpcHealth, healthpb.HealthCheckResponse_SERVING)
+	down.Store(true)
+	waitServingStatus(t, grpcHealth, healthpb.HealthCheckResponse_NOT_SERVING)
+	down.Store(false)
+	waitServingStatus(t, grpcHealth, healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v, want nil", err)
+	}
+	if got := servingStatus(t, grpcHealth); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("serving status after Run = %v, want NOT_SERVING", got)
+	}
+	if report := checker.Ready(context.Background()); report.Status != StatusDraining {
+		t.Errorf("Ready() after Run = %+v, want draining", report)
+	}
+}
@@ -0,0 +1,232 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+// service is a server that runs until it fails or is stopped
+type service struct {
+	name string
+	run  func() error
+	stop func(ctx context.Context) error
+}
+
+// worker is a background task that runs until its context is cancelled
+type worker struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// closer releases a resource the services and workers depend on
+type closer struct {
+	name  string
+	close func(ctx context.Context) error
+}
+
+// Worker states reported by CheckWorker
+const (
+	WorkerPending = "pending"
+	WorkerRunning = "running"
+	WorkerStopped = "stopped"
+	WorkerFailed  = "failed"
+)
+
+// Manager runs the servers and background workers of the application under one errgroup. When
+// the run context is cancelled or any of them fails, it stops the workers, keeps serving for the
+// drain delay so that load balancers see the instance is no longer ready, drains the servers
+// within the shutdown deadline and then releases the resources in the order they were registered.
+type Manager struct {
+	drainDelay      time.Duration
+	shutdownTimeout time.Duration
+	services        []service
+	workers         []worker
+	closers         []closer
+
+	mu           sync.Mutex
+	workerStates map[string]string
+}
+
+// New creates a manager that keeps serving for drainDelay once the shutdown starts, then gives
+// the servers shutdownTimeout to drain
+func New(drainDelay, shutdownTimeout time.Duration) *Manager {
+	return &Manager{
+		drainDelay:      drainDelay,
+		shutdownTimeout: shutdownTimeout,
+		workerStates:    map[string]string{},
+	}
+}
+
+// AddService registers a server; run blocks until the server fails or stop has drained it, in
+// which case it returns nil
+func (m *Manager) AddService(name string, run func() error, stop func(ctx context.Context) error) {
+	m.services = append(m.services, service{name: name, run: run, stop: stop})
+}
+
+// AddHTTPServer registers an HTTP server, drained with Shutdown
+func (m *Manager) AddHTTPServer(name string, server *http.Server) {
+	m.AddService(name, func() error {
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}, server.Shutdown)
+}
+
+// AddGRPCServer registers a gRPC server serving on listener, drained with GracefulStop and
+// stopped outright when the deadline passes first
+func (m *Manager) AddGRPCServer(name string, server *grpc.Server, listener net.Listener) {
+	m.AddService(name, func() error {
+		return server.Serve(listener)
+	}, func(ctx context.Context) error {
+		drained := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(drained)
+		}()
+
+		select {
+		case <-drained:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return ctx.Err()
+		}
+	})
+}
+
+// AddWorker registers a background worker, cancelled as soon as the shutdown starts
+func (m *Manager) AddWorker(name string, run func(ctx context.Context) error) {
+	m.workers = append(m.workers, worker{name: name, run: run})
+	m.setWorkerState(name, WorkerPending)
+}
+
+// CheckWorker returns a check reporting an error unless the named worker is running
+func (m *Manager) CheckWorker(name string) func(ctx context.Context) error {
+	return func(context.Context) error {
+		m.mu.Lock()
+		state, ok := m.workerStates[name]
+		m.mu.Unlock()
+
+		if !ok {
+			return fmt.Errorf("unknown worker %s", name)
+		}
+		if state != WorkerRunning {
+			return errors.New(state)
+		}
+		return nil
+	}
+}
+
+func (m *Manager) setWorkerState(name, state string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workerStates[name] = state
+}
+
+// OnClose registers a resource released once every service and worker has stopped
+func (m *Manager) OnClose(name string, close func(ctx context.Context) error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run starts every service and worker and blocks until ctx is cancelled or one of them fails,
+// then shuts everything down. It returns the failure that caused the shutdown, if any, joined
+// with the errors met while shutting down.
+func (m *Manager) Run(ctx context.Context) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	workerCtx, cancelWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelWorkers()
+
+	for _, s := range m.services {
+		group.Go(func() error {
+			slog.Info("starting service", slog.String("service", s.name))
+			if err := s.run(); err != nil {
+				return fmt.Errorf("%s: %w", s.name, err)
+			}
+			return nil
+		})
+	}
+	// Every worker is marked running before any starts, so that no check sees a sibling pending
+	for _, w := range m.workers {
+		m.setWorkerState(w.name, WorkerRunning)
+	}
+	for _, w := range m.workers {
+		group.Go(func() error {
+			if err := w.run(workerCtx); err != nil && workerCtx.Err() == nil {
+				m.setWorkerState(w.name, WorkerFailed+": "+err.Error())
+				return fmt.Errorf("%s: %w", w.name, err)
+			}
+			m.setWorkerState(w.name, WorkerStopped)
+			return nil
+		})
+	}
+
+	// The drain deadline starts with the shutdown and also bounds the release of the resources
+	var (
+		drainCtx    context.Context
+		cancelDrain context.CancelFunc
+		stopErr     error
+	)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-groupCtx.Done()
+		// Readiness turns as the workers stop; keep serving a while on a requested shutdown so that
+		// load balancers stop routing here before the listeners close
+		cancelWorkers()
+		if m.drainDelay > 0 && ctx.Err() != nil {
+			slog.Info("draining", slog.Duration("delay", m.drainDelay))
+			time.Sleep(m.drainDelay)
+		}
+
+		slog.Info("shutting down", slog.Duration("deadline", m.shutdownTimeout))
+		drainCtx, cancelDrain = context.WithTimeout(context.WithoutCancel(ctx), m.shutdownTimeout)
+		stopErr = m.stop(drainCtx)
+	}()
+
+	err := group.Wait()
+	<-stopped
+	defer cancelDrain()
+
+	return errors.Join(err, stopErr, m.close(drainCtx))
+}
+
+// stop drains the services concurrently
+func (m *Manager) stop(ctx context.Context) error {
+	errs := make([]error, len(m.services))
+	var wg sync.WaitGroup
+	for i, s := range m.services {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.stop(ctx); err != nil {
+				errs[i] = fmt.Errorf("stop %s: %w", s.name, err)
+			}
+			slog.Info("stopped service", slog.String("service", s.name))
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// close releases the resources in registration order
+func (m *Manager) close(ctx context.Context) error {
+	var errs []error
+	for _, c := range m.closers {
+		if err := c.close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", c.name, err))
+			continue
+		}
+		slog.Info("closed resource", slog.String("resource", c.name))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,265 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// events records the order in which the fake components start, stop and close
+type events struct {
+	mu   sync.Mutex
+	list []string
+}
+
+func (e *events) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, event)
+}
+
+func (e *events) get() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return slices.Clone(e.list)
+}
+
+// fakeService serves until stopped; stop fails with stopErr, or blocks until its deadline when
+// hang is set
+type fakeService struct {
+	name    string
+	events  *events
+	stopErr error
+	hang    bool
+
+	once    sync.Once
+	stopped chan struct{}
+}
+
+func newFakeService(name string, events *events) *fakeService {
+	return &fakeService{name: name, events: events, stopped: make(chan struct{})}
+}
+
+func (s *fakeService) add(m *Manager) {
+	m.AddService(s.name, s.run, s.stop)
+}
+
+func (s *fakeService) run() error {
+	<-s.stopped
+	return nil
+}
+
+func (s *fakeService) stop(ctx context.Context) error {
+	defer s.once.Do(func() { close(s.stopped) })
+	s.events.add("stop " + s.name)
+
+	if s.hang {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return s.stopErr
+}
+
+// addWorker registers a worker that runs until cancelled, recording when it stops
+func addWorker(m *Manager, name string, events *events) {
+	m.AddWorker(name, func(ctx context.Context) error {
+		<-ctx.Done()
+		events.add("cancel " + name)
+		return ctx.Err()
+	})
+}
+
+// addCloser registers a closer recording its name and failing with err
+func addCloser(m *Manager, name string, events *events, err error) {
+	m.OnClose(name, func(context.Context) error {
+		events.add("close " + name)
+		return err
+	})
+}
+
+// runManager runs m in the background and returns a channel receiving the result of Run
+func runManager(ctx context.Context, m *Manager) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- m.Run(ctx)
+	}()
+	return done
+}
+
+func waitRun(t *testing.T, done <-chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return")
+		return nil
+	}
+}
+
+func waitRunning(t *testing.T, m *Manager, worker string) {
+	t.Helper()
+
+	check := m.CheckWorker(worker)
+	for deadline := time.Now().Add(5 * time.Second); check(context.Background()) != nil; {
+		if time.Now().After(deadline) {
+			t.Fatalf("worker %s is not running: %v", worker, check(context.Background()))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestManager_GracefulShutdown(t *testing.T) {
+	const drainDelay = 50 * time.Millisecond
+	events := &events{}
+	m := New(drainDelay, time.Second)
+	newFakeService("http", events).add(m)
+	addWorker(m, "refresher", events)
+	addCloser(m, "cache", events, nil)
+	addCloser(m, "database", events, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runManager(ctx, m)
+	waitRunning(t, m, "refresher")
+
+	cancelled := time.Now()
+	cancel()
+	if err := waitRun(t, done); err != nil {
+		t.Fatalf("Run() error = %v, want nil", err)
+	}
+
+	if elapsed := time.Since(cancelled); elapsed < drainDelay {
+		t.Errorf("Run returned %v after the cancellation, want it to keep serving for %v", elapsed, drainDelay)
+	}
+	want := []string{"cancel refresher", "stop http", "close cache", "close database"}
+	if got := events.get(); !slices.Equal(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	if err := m.CheckWorker("refresher")(context.Background()); err == nil || err.Error() != WorkerStopped {
+		t.Errorf("CheckWorker(refresher) = %v, want %q", err, WorkerStopped)
+	}
+}
+
+func TestManager_ShutdownTimeout(t *testing.T) {
+	const shutdownTimeout = 50 * time.Millisecond
+	events := &events{}
+	m := New(0, shutdownTimeout)
+	stuck := newFakeService("grpc", events)
+	stuck.hang = true
+	stuck.add(m)
+	newFakeService("http", events).add(m)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runManager(ctx, m)
+
+	cancelled := time.Now()
+	cancel()
+	err := waitRun(t, done)
+
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "stop grpc") {
+		t.Errorf("Run() error = %v, want the grpc stop to exceed its deadline", err)
+	}
+	if elapsed := time.Since(cancelled); elapsed < shutdownTimeout || elapsed > time.Second {
+		t.Errorf("Run returned %v after the cancellation, want about %v", elapsed, shutdownTimeout)
+	}
+	if got := events.get(); !slices.Contains(got, "stop http") {
+		t.Errorf("events = %v, want the http server stopped alongside", got)
+	}
+}
+
+func TestManager_FailureStopsEverything(t *testing.T) {
+	failure := errors.New("connection lost")
+
+	tests := []struct {
+		name    string
+		setup   func(m *Manager, events *events)
+		wantErr string
+	}{
+		{
+			name: "worker fails",
+			setup: func(m *Manager, events *events) {
+				m.AddWorker("consumer", func(context.Context) error { return failure })
+			},
+			wantErr: "consumer: connection lost",
+		},
+		{
+			name: "service fails",
+			setup: func(m *Manager, events *events) {
+				m.AddService("grpc", func() error { return failure }, func(context.Context) error { return nil })
+			},
+			wantErr: "grpc: connection lost",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := &events{}
+			// A failure skips the drain delay, which would otherwise outlast the test
+			m := New(time.Hour, time.Second)
+			newFakeService("http", events).add(m)
+			addWorker(m, "refresher", events)
+			addCloser(m, "database", events, nil)
+			tt.setup(m, events)
+
+			err := waitRun(t, runManager(context.Background(), m))
+			if !errors.Is(err, failure) || err.Error() != tt.wantErr {
+				t.Errorf("Run() error = %v, want %q", err, tt.wantErr)
+			}
+
+			got := events.get()
+			for _, want := range []string{"cancel refresher", "stop http", "close database"} {
+				if !slices.Contains(got, want) {
+					t.Errorf("events = %v, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestManager_FailedWorkerState(t *testing.T) {
+	m := New(0, time.Second)
+	m.AddWorker("consumer", func(context.Context) error { return errors.New("connection lost") })
+
+	if err := m.CheckWorker("consumer")(context.Background()); err == nil || err.Error() != WorkerPending {
+		t.Errorf("CheckWorker before Run = %v, want %q", err, WorkerPending)
+	}
+
+	_ = waitRun(t, runManager(context.Background(), m))
+	if err := m.CheckWorker("consumer")(context.Background()); err == nil || err.Error() != WorkerFailed+": connection lost" {
+		t.Errorf("CheckWorker after the failure = %v, want it failed", err)
+	}
+	if err := m.CheckWorker("unknown")(context.Background()); err == nil {
+		t.Error("CheckWorker(unknown) = nil, want an error")
+	}
+}
+
+func TestManager_ShutdownErrors(t *testing.T) {
+	stopErr := errors.New("listener busy")
+	closeErr := errors.New("flush failed")
+	events := &events{}
+	m := New(0, time.Second)
+	failing := newFakeService("http", events)
+	failing.stopErr = stopErr
+	failing.add(m)
+	addCloser(m, "cache", events, closeErr)
+	addCloser(m, "database", events, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runManager(ctx, m)
+	cancel()
+	err := waitRun(t, done)
+
+	if !errors.Is(err, stopErr) || !errors.Is(err, closeErr) {
+		t.Errorf("Run() error = %v, want both the stop and the close errors", err)
+	}
+	// A failing closer does not keep the later resources from being released
+	want := []string{"stop http", "close cache", "close database"}
+	if got := events.get(); !slices.Equal(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDKey names the request ID in log records
+const RequestIDKey = "request_id"
+
+// maxRequestIDLength bounds the request IDs accepted from callers
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose logs and downstream calls carry the given request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID of the current request, or "" outside of one
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// RequestID returns the ID a caller sent when it is safe to log, or a new random ID otherwise
+func RequestID(incoming string) string {
+	if validRequestID(incoming) {
+		return incoming
+	}
+
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// validRequestID accepts short IDs made of letters, digits and the separators common in trace IDs
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
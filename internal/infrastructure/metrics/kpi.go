@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+var (
+	packagesByStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "packages",
+		Help:      "Stored packages, by status, as of the last KPI refresh.",
+	}, []string{"status"})
+
+	activeRoutes = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_routes",
+		Help:      "Routes being driven, as of the last KPI refresh.",
+	})
+
+	// deliveries is a counter so that deliveries per hour is increase(deliveryplanner_deliveries_total[1h])
+	deliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deliveries_total",
+		Help:      "Delivery attempts recorded by this instance, by outcome: delivered or failed.",
+	}, []string{"outcome"})
+)
+
+// packageStatuses lists every status reported by the packages gauge, so empty ones read 0
+var packageStatuses = []models.PackageStatus{
+	models.PackageStatusPending,
+	models.PackageStatusAssigned,
+	models.PackageStatusOutForDelivery,
+	models.PackageStatusDelivered,
+	models.PackageStatusFailed,
+	models.PackageStatusReturned,
+	models.PackageStatusCancelled,
+}
+
+// RecordPackageStatus counts a package that has just moved to status when it ends a delivery attempt
+func RecordPackageStatus(status models.PackageStatus) {
+	switch status {
+	case models.PackageStatusDelivered:
+		deliveries.WithLabelValues("delivered").Inc()
+	case models.PackageStatusFailed:
+		deliveries.WithLabelValues("failed").Inc()
+	}
+}
+
+// KPIRefresher periodically recomputes the business gauges from the stored packages and routes,
+// so that they hold across restarts and agree between instances
+type KPIRefresher struct {
+	packageRepo repositories.PackageRepository
+	routeRepo   repositories.RouteRepository
+	interval    time.Duration
+}
+
+// NewKPIRefresher creates a refresher recomputing the gauges every interval
+func NewKPIRefresher(packageRepo repositories.PackageRepository, routeRepo repositories.RouteRepository, interval time.Duration) *KPIRefresher {
+	return &KPIRefresher{
+		packageRepo: packageRepo,
+		routeRepo:   routeRepo,
+		interval:    interval,
+	}
+}
+
+// Run refreshes the gauges right away and then every interval until ctx is done
+func (r *KPIRefresher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to refresh KPI metrics", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh recomputes the packages by status and active routes gauges
+func (r *KPIRefresher) Refresh(ctx context.Context) error {
+	for _, status := range packageStatuses {
+		count, err := r.packageRepo.Count(ctx, repositories.PackageFilter{Status: status})
+		if err != nil {
+			return err
+		}
+		packagesByStatus.WithLabelValues(string(status)).Set(float64(count))
+	}
+
+	count, err := r.routeRepo.Count(ctx, repositories.RouteFilter{Status: models.RouteStatusActive})
+	if err != nil {
+		return err
+	}
+	activeRoutes.Set(float64(count))
+	return nil
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes the metrics specific to the delivery planner
+const namespace = "deliveryplanner"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests served, by method, route template and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time taken to serve HTTP requests, by method and route template.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_requests_total",
+		Help: "gRPC calls served, by full method name and status code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_request_duration_seconds",
+		Help:    "Time taken to serve gRPC calls, by full method name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	mongoOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mongodb_operation_duration_seconds",
+		Help:    "Time taken by MongoDB commands, by collection, command and outcome.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"collection", "command", "outcome"})
+)
+
+// Handler serves the registered metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHTTPRequest records a served HTTP request; route is the route template, not the raw path,
+// so that path parameters do not multiply the series
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ObserveGRPCCall records a served gRPC call with the name of its status code
+func ObserveGRPCCall(method, code string, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObserveMongoOperation records a MongoDB command that either succeeded or failed
+func ObserveMongoOperation(collection, command string, failed bool, duration time.Duration) {
+	outcome := "success"
+	if failed {
+		outcome = "error"
+	}
+	mongoOperationDuration.WithLabelValues(collection, command, outcome).Observe(duration.Seconds())
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type DriverRepository struct {
+	drivers *table[models.Driver]
+}
+
+func NewDriverRepository() *DriverRepository {
+	return &DriverRepository{
+		drivers: newTable[models.Driver](),
+	}
+}
+
+func (r *DriverRepository) Create(ctx context.Context, driver *models.Driver) error {
+	driver.ID = primitive.NewObjectID()
+	driver.CreatedAt = time.Now()
+	driver.UpdatedAt = time.Now()
+	driver.Version = 1
+
+	return r.drivers.insert(driver.ID, driver)
+}
+
+func (r *DriverRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Driver, error) {
+	driver, ok, err := r.drivers.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, repositories.NotFound("driver", id.Hex())
+	}
+	return driver, nil
+}
+
+func (r *DriverRepository) List(ctx context.Context, filter repositories.DriverFilter, page repositories.PageRequest) (*repositories.Page[models.Driver], error) {
+	return r.drivers.findPage(func(driver *models.Driver) bool {
+		return matchDriver(driver, filter)
+	}, page, driverID)
+}
+
+func (r *DriverRepository) Update(ctx context.Context, driver *models.Driver) error {
+	driver.UpdatedAt = time.Now()
+
+	return updateVersioned(r.drivers, "driver", driver.ID, driver, &driver.Version, driverVersion)
+}
+
+func (r *DriverRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	r.drivers.delete(id)
+	return nil
+}
+
+func driverID(driver *models.Driver) primitive.ObjectID {
+	return driver.ID
+}
+
+func driverVersion(driver *models.Driver) int64 {
+	return driver.Version
+}
+
+func matchDriver(driver *models.Driver, filter repositories.DriverFilter) bool {
+	if filter.VehicleType != "" && driver.VehicleType != filter.VehicleType {
+		return false
+	}
+	if filter.Active != nil && driver.Active != *filter.Active {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+type PackageEventRepository struct {
+	events *table[models.PackageEvent]
+}
+
+func NewPackageEventRepository() *PackageEventRepository {
+	return &PackageEventRepository{
+		events: newTable[models.PackageEvent](),
+	}
+}
+
+// Append stores a new event; events are never updated or deleted
+func (r *PackageEventRepository) Append(ctx context.Context, event *models.PackageEvent) error {
+	event.ID = primitive.NewObjectID()
+
+	return r.events.insert(event.ID, event)
+}
+
+// ListByPackageID returns a package's events in the order they happened
+func (r *PackageEventRepository) ListByPackageID(ctx context.Context, packageID primitive.ObjectID) ([]*models.PackageEvent, error) {
+	events, err := r.events.find(func(event *models.PackageEvent) bool {
+		return event.PackageID == packageID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+	return events, nil
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type PackageRepository struct {
+	// mu serialises writes so the tracking number check and the write happen atomically,
+	// as MongoDB does with its unique index
+	mu       sync.Mutex
+	packages *table[models.Package]
+}
+
+func NewPackageRepository() *PackageRepository {
+	return &PackageRepository{
+		packages: newTable[models.Package](),
+	}
+}
+
+func (r *PackageRepository) Create(ctx context.Context, pkg *models.Package) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTrackingNumber(pkg.TrackingNumber, primitive.NilObjectID); err != nil {
+		return err
+	}
+
+	pkg.ID = primitive.NewObjectID()
+	pkg.CreatedAt = time.Now()
+	pkg.UpdatedAt = time.Now()
+	pkg.Version = 1
+
+	return r.packages.insert(pkg.ID, pkg)
+}
+
+func (r *PackageRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Package, error) {
+	pkg, ok, err := r.packages.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, repositories.NotFound("package", id.Hex())
+	}
+	return pkg, nil
+}
+
+func (r *PackageRepository) GetByTrackingNumber(ctx context.Context, trackingNumber string) (*models.Package, error) {
+	packages, err := r.packages.find(func(pkg *models.Package) bool {
+		return pkg.TrackingNumber == trackingNumber
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return nil, repositories.NotFound("package", trackingNumber)
+	}
+	return packages[0], nil
+}
+
+func (r *PackageRepository) List(ctx context.Context, filter repositories.PackageFilter, page repositories.PageRequest) (*repositories.Page[models.Package], error) {
+	return r.packages.findPage(func(pkg *models.Package) bool {
+		return matchPackage(pkg, filter)
+	}, page, packageID)
+}
+
+func (r *PackageRepository) Count(ctx context.Context, filter repositories.PackageFilter) (int64, error) {
+	return r.packages.count(func(pkg *models.Package) bool {
+		return matchPackage(pkg, filter)
+	}), nil
+}
+
+func (r *PackageRepository) Update(ctx context.Context, pkg *models.Package) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTrackingNumber(pkg.TrackingNumber, pkg.ID); err != nil {
+		return err
+	}
+
+	pkg.UpdatedAt = time.Now()
+
+	return updateVersioned(r.packages, "package", pkg.ID, pkg, &pkg.Version, packageVersion)
+}
+
+func (r *PackageRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	r.packages.delete(id)
+	return nil
+}
+
+// checkTrackingNumber rejects a tracking number already used by a package other than self
+func (r *PackageRepository) checkTrackingNumber(trackingNumber string, self primitive.ObjectID) error {
+	taken, err := r.packages.find(func(pkg *models.Package) bool {
+		return pkg.TrackingNumber == trackingNumber && pkg.ID != self
+	})
+	if err != nil {
+		return err
+	}
+	if len(taken) > 0 {
+		return models.Conflictf("package %s already exists", trackingNumber)
+	}
+	return nil
+}
+
+func packageID(pkg *models.Package) primitive.ObjectID {
+	return pkg.ID
+}
+
+func packageVersion(pkg *models.Package) int64 {
+	return pkg.Version
+}
+
+func matchPackage(pkg *models.Package, filter repositories.PackageFilter) bool {
+	if filter.Status != "" && pkg.Status != filter.Status {
+		return false
+	}
+	if !strings.HasPrefix(pkg.TrackingNumber, filter.TrackingPrefix) {
+		return false
+	}
+	if !filter.CreatedFrom.IsZero() && pkg.CreatedAt.Before(filter.CreatedFrom) {
+		return false
+	}
+	if !filter.CreatedTo.IsZero() && pkg.CreatedAt.After(filter.CreatedTo) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type PlanRepository struct {
+	plans *table[models.Plan]
+}
+
+func NewPlanRepository() *PlanRepository {
+	return &PlanRepository{
+		plans: newTable[models.Plan](),
+	}
+}
+
+func (r *PlanRepository) Create(ctx context.Context, plan *models.Plan) error {
+	plan.ID = primitive.NewObjectID()
+	plan.CreatedAt = time.Now()
+	plan.UpdatedAt = time.Now()
+
+	return r.plans.insert(plan.ID, plan)
+}
+
+func (r *PlanRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Plan, error) {
+	plan, ok, err := r.plans.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, repositories.NotFound("plan", id.Hex())
+	}
+	return plan, nil
+}
+
+func (r *PlanRepository) Update(ctx context.Context, plan *models.Plan) error {
+	plan.UpdatedAt = time.Now()
+
+	return r.plans.replace(plan.ID, plan)
+}
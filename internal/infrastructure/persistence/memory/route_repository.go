@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type RouteRepository struct {
+	routes *table[models.Route]
+}
+
+func NewRouteRepository() *RouteRepository {
+	return &RouteRepository{
+		routes: newTable[models.Route](),
+	}
+}
+
+func (r *RouteRepository) Create(ctx context.Context, route *models.Route) error {
+	route.ID = primitive.NewObjectID()
+	route.CreatedAt = time.Now()
+	route.UpdatedAt = time.Now()
+	route.Version = 1
+
+	return r.routes.insert(route.ID, route)
+}
+
+func (r *RouteRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Route, error) {
+	route, ok, err := r.routes.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, repositories.NotFound("route", id.Hex())
+	}
+	return route, nil
+}
+
+func (r *RouteRepository) List(ctx context.Context, filter repositories.RouteFilter, page repositories.PageRequest) (*repositories.Page[models.Route], error) {
+	return r.routes.findPage(func(route *models.Route) bool {
+		return matchRoute(route, filter)
+	}, page, routeID)
+}
+
+func (r *RouteRepository) Count(ctx context.Context, filter repositories.RouteFilter) (int64, error) {
+	return r.routes.count(func(route *models.Route) bool {
+		return matchRoute(route, filter)
+	}), nil
+}
+
+func (r *RouteRepository) Update(ctx context.Context, route *models.Route) error {
+	route.UpdatedAt = time.Now()
+
+	return updateVersioned(r.routes, "route", route.ID, route, &route.Version, routeVersion)
+}
+
+func (r *RouteRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	r.routes.delete(id)
+	return nil
+}
+
+func (r *RouteRepository) GetByDriverID(ctx context.Context, driverID primitive.ObjectID) ([]*models.Route, error) {
+	return r.routes.find(func(route *models.Route) bool {
+		return route.DriverID == driverID
+	})
+}
+
+func (r *RouteRepository) UpdatePackageStatus(ctx context.Context, route *models.Route, packageID primitive.ObjectID) error {
+	stop := route.Stop(packageID)
+	if stop == nil {
+		return models.NotFoundf("package %s is not on this route", packageID.Hex())
+	}
+
+	// Rewrite only the stop on top of the stored route, as the MongoDB backend does
+	stored, err := r.GetByID(ctx, route.ID)
+	if err != nil {
+		return err
+	}
+	storedStop := stored.Stop(packageID)
+	if storedStop == nil {
+		return repositories.StaleVersion("route", route.ID.Hex(), route.Version)
+	}
+	*storedStop = *stop
+	stored.UpdatedAt = route.UpdatedAt
+	stored.Version = route.Version
+
+	if err := updateVersioned(r.routes, "route", stored.ID, stored, &stored.Version, routeVersion); err != nil {
+		return err
+	}
+	route.Version = stored.Version
+	return nil
+}
+
+func routeID(route *models.Route) primitive.ObjectID {
+	return route.ID
+}
+
+func routeVersion(route *models.Route) int64 {
+	return route.Version
+}
+
+func matchRoute(route *models.Route, filter repositories.RouteFilter) bool {
+	if filter.Status != "" && route.Status != filter.Status {
+		return false
+	}
+	if !filter.DriverID.IsZero() && route.DriverID != filter.DriverID {
+		return false
+	}
+	if !filter.DateFrom.IsZero() && route.Date.Before(filter.DateFrom) {
+		return false
+	}
+	if !filter.DateTo.IsZero() && route.Date.After(filter.DateTo) {
+		return false
+	}
+	if filter.Delivered != nil {
+		outstanding := hasOutstandingStop(route)
+		if *filter.Delivered {
+			return len(route.Packages) > 0 && !outstanding
+		}
+		return outstanding
+	}
+	return true
+}
+
+// hasOutstandingStop reports whether a stop of the route is neither delivered nor failed
+func hasOutstandingStop(route *models.Route) bool {
+	for _, stop := range route.Packages {
+		if !stop.Delivered && !stop.Failed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package memory
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// table is a thread-safe set of documents keyed by ID. Documents are copied on the way in and
+// out so callers never share state with the store, as they would not with a database.
+type table[T any] struct {
+	mu   sync.RWMutex
+	rows map[primitive.ObjectID]*T
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{rows: make(map[primitive.ObjectID]*T)}
+}
+
+func (t *table[T]) insert(id primitive.ObjectID, doc *T) error {
+	stored, err := clone(doc)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rows[id] = stored
+	return nil
+}
+
+func (t *table[T]) get(id primitive.ObjectID) (*T, bool, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	doc, ok := t.rows[id]
+	if !ok {
+		return nil, false, nil
+	}
+	copied, err := clone(doc)
+	return copied, true, err
+}
+
+// replace overwrites an existing document; like a database update it is a no-op when the ID is unknown
+func (t *table[T]) replace(id primitive.ObjectID, doc *T) error {
+	stored, err := clone(doc)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.rows[id]; ok {
+		t.rows[id] = stored
+	}
+	return nil
+}
+
+// replaceIf overwrites an existing document when match accepts the stored one, reporting whether
+// the document exists and whether it was replaced
+func (t *table[T]) replaceIf(id primitive.ObjectID, doc *T, match func(stored *T) bool) (found, replaced bool, err error) {
+	stored, err := clone(doc)
+	if err != nil {
+		return false, false, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	current, ok := t.rows[id]
+	if !ok || !match(current) {
+		return ok, false, nil
+	}
+	t.rows[id] = stored
+	return true, true, nil
+}
+
+// updateVersioned replaces a document still stored at *version and advances *version, failing like
+// the MongoDB backend when the document is missing or was changed by another writer
+func updateVersioned[T any](t *table[T], entity string, id primitive.ObjectID, doc *T, version *int64, versionOf func(*T) int64) error {
+	expected := *version
+	*version = expected + 1
+
+	found, replaced, err := t.replaceIf(id, doc, func(stored *T) bool {
+		return versionOf(stored) == expected
+	})
+	switch {
+	case err != nil:
+	case !found:
+		err = repositories.NotFound(entity, id.Hex())
+	case !replaced:
+		err = repositories.StaleVersion(entity, id.Hex(), expected)
+	}
+	if err != nil {
+		*version = expected
+	}
+	return err
+}
+
+func (t *table[T]) delete(id primitive.ObjectID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.rows, id)
+}
+
+// find returns copies of the matching documents in insertion order
+func (t *table[T]) find(match func(*T) bool) ([]*T, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	ids := make([]primitive.ObjectID, 0, len(t.rows))
+	for id, doc := range t.rows {
+		if match == nil || match(doc) {
+			ids = append(ids, id)
+		}
+	}
+	// ObjectIDs start with their creation time, so sorting them restores insertion order
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Hex() < ids[j].Hex()
+	})
+
+	docs := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		copied, err := clone(t.rows[id])
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, copied)
+	}
+	return docs, nil
+}
+
+// count returns the number of matching documents
+func (t *table[T]) count(match func(*T) bool) int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var n int64
+	for _, doc := range t.rows {
+		if match(doc) {
+			n++
+		}
+	}
+	return n
+}
+
+// findPage returns copies of one page of the matching documents, ordered by ID like the listing cursor
+func (t *table[T]) findPage(match func(*T) bool, page repositories.PageRequest, id func(*T) primitive.ObjectID) (*repositories.Page[T], error) {
+	after, err := page.After()
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := t.find(match)
+	if err != nil {
+		return nil, err
+	}
+	if page.Descending {
+		slices.Reverse(docs)
+	}
+
+	if !after.IsZero() {
+		start := len(docs)
+		for i, doc := range docs {
+			docID := id(doc)
+			if cmp := bytes.Compare(docID[:], after[:]); page.Descending && cmp < 0 || !page.Descending && cmp > 0 {
+				start = i
+				break
+			}
+		}
+		docs = docs[start:]
+	}
+	if len(docs) > page.Size()+1 {
+		docs = docs[:page.Size()+1]
+	}
+	return repositories.NewPage(docs, page, id), nil
+}
+
+// clone deep-copies a document through its BSON encoding, which also gives it the same
+// shape and time precision it would have after a round trip through MongoDB
+func clone[T any](doc *T) (*T, error) {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var copied T
+	if err := bson.Unmarshal(data, &copied); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
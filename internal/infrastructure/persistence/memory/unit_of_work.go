@@ -0,0 +1,14 @@
+package memory
+
+import "context"
+
+// UnitOfWork runs use cases directly: the in-memory repositories have no transactions to join
+type UnitOfWork struct{}
+
+func NewUnitOfWork() *UnitOfWork {
+	return &UnitOfWork{}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -2,27 +2,53 @@ package mongodb
 
 import (
 	"context"
-	"log"
-	"os"
+	"log/slog"
 	"time"
 
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 )
 
-// NewClient creates a new MongoDB client
-func NewClient() (*mongo.Client, error) {
+// ClientConfig holds the connection settings of the MongoDB client; zero pool sizes and timeouts
+// keep the driver defaults
+type ClientConfig struct {
+	URI                    string
+	MinPoolSize            uint64
+	MaxPoolSize            uint64
+	ConnectTimeout         time.Duration
+	ServerSelectionTimeout time.Duration
+	// Timeout bounds every operation that has no earlier deadline of its own
+	Timeout time.Duration
+}
+
+// NewClient creates a new MongoDB client and checks that the server is reachable
+func NewClient(cfg ClientConfig) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Get MongoDB connection string from environment variable or use default
-	mongoURI := "mongodb://localhost:27017"
-	if uri := os.Getenv("MONGODB_URI"); uri != "" {
-		mongoURI = uri
+	// Create MongoDB client, timing and tracing the commands it sends
+	opts := options.Client().
+		ApplyURI(cfg.URI).
+		SetMonitor(combineMonitors(newCommandMonitor(), otelmongo.NewMonitor()))
+	if cfg.MinPoolSize > 0 {
+		opts.SetMinPoolSize(cfg.MinPoolSize)
+	}
+	if cfg.MaxPoolSize > 0 {
+		opts.SetMaxPoolSize(cfg.MaxPoolSize)
+	}
+	if cfg.ConnectTimeout > 0 {
+		opts.SetConnectTimeout(cfg.ConnectTimeout)
+	}
+	if cfg.ServerSelectionTimeout > 0 {
+		opts.SetServerSelectionTimeout(cfg.ServerSelectionTimeout)
+	}
+	if cfg.Timeout > 0 {
+		opts.SetTimeout(cfg.Timeout)
 	}
 
-	// Create MongoDB client
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +58,34 @@ func NewClient() (*mongo.Client, error) {
 		return nil, err
 	}
 
-	log.Println("Connected to MongoDB!")
+	slog.Info("connected to MongoDB")
 	return client, nil
 }
+
+// combineMonitors creates a command monitor notifying each of monitors in turn, since the driver
+// accepts a single one
+func combineMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type DriverRepository struct {
+	collection *mongo.Collection
+}
+
+func NewDriverRepository(db *mongo.Database) *DriverRepository {
+	return &DriverRepository{
+		collection: db.Collection("drivers"),
+	}
+}
+
+func (r *DriverRepository) Create(ctx context.Context, driver *models.Driver) error {
+	driver.CreatedAt = time.Now()
+	driver.UpdatedAt = time.Now()
+	driver.Version = 1
+
+	result, err := r.collection.InsertOne(ctx, driver)
+	if err != nil {
+		return err
+	}
+
+	driver.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *DriverRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Driver, error) {
+	var driver models.Driver
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&driver)
+	if err != nil {
+		return nil, translateError(err, "driver", id.Hex())
+	}
+	return &driver, nil
+}
+
+func (r *DriverRepository) List(ctx context.Context, filter repositories.DriverFilter, page repositories.PageRequest) (*repositories.Page[models.Driver], error) {
+	query := bson.M{}
+	if filter.VehicleType != "" {
+		query["vehicle_type"] = filter.VehicleType
+	}
+	if filter.Active != nil {
+		query["active"] = *filter.Active
+	}
+
+	return findPage(ctx, r.collection, query, page, func(driver *models.Driver) primitive.ObjectID {
+		return driver.ID
+	})
+}
+
+func (r *DriverRepository) Update(ctx context.Context, driver *models.Driver) error {
+	driver.UpdatedAt = time.Now()
+	driver.Version++
+
+	if err := replaceVersioned(ctx, r.collection, "driver", driver.ID, driver.Version-1, driver); err != nil {
+		driver.Version--
+		return err
+	}
+	return nil
+}
+
+func (r *DriverRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
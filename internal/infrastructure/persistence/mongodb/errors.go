@@ -0,0 +1,22 @@
+package mongodb
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// translateError converts driver errors about a document into the domain error kinds
+func translateError(err error, entity, key string) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return repositories.NotFound(entity, key)
+	case mongo.IsDuplicateKeyError(err):
+		return models.Conflictf("%s %s already exists", entity, key)
+	default:
+		return err
+	}
+}
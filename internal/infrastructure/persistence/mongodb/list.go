@@ -0,0 +1,66 @@
+package mongodb
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// findPage runs a filtered query for one page of a listing, ordered by _id like the listing cursor
+func findPage[T any](ctx context.Context, collection *mongo.Collection, filter bson.M, page repositories.PageRequest, id func(*T) primitive.ObjectID) (*repositories.Page[T], error) {
+	after, err := page.After()
+	if err != nil {
+		return nil, err
+	}
+
+	order, resumeAfter := 1, "$gt"
+	if page.Descending {
+		order, resumeAfter = -1, "$lt"
+	}
+	if !after.IsZero() {
+		filter["_id"] = bson.M{resumeAfter: after}
+	}
+
+	// Fetch one document past the page to know whether the listing continues
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: order}}).
+		SetLimit(int64(page.Size() + 1))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*T
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return repositories.NewPage(docs, page, id), nil
+}
+
+// timeRange builds the condition matching times between from and to, either of which may be unbounded
+func timeRange(from, to time.Time) bson.M {
+	bounds := bson.M{}
+	if !from.IsZero() {
+		bounds["$gte"] = from
+	}
+	if !to.IsZero() {
+		bounds["$lte"] = to
+	}
+	if len(bounds) == 0 {
+		return nil
+	}
+	return bounds
+}
+
+// prefixPattern builds an anchored regular expression so a prefix match can use the field's index
+func prefixPattern(prefix string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)}
+}
@@ -0,0 +1,58 @@
+package mongodb
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+)
+
+// commandMetrics times every command the repositories send, labelled with its collection. The
+// finished events do not carry the command, so the collection is remembered from the started one.
+type commandMetrics struct {
+	collections sync.Map // request ID -> collection name
+}
+
+// newCommandMonitor creates the driver monitor recording the MongoDB operation timings
+func newCommandMonitor() *event.CommandMonitor {
+	m := &commandMetrics{}
+	return &event.CommandMonitor{
+		Started: m.started,
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			m.finished(e.CommandFinishedEvent, false)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			m.finished(e.CommandFinishedEvent, true)
+		},
+	}
+}
+
+func (m *commandMetrics) started(_ context.Context, e *event.CommandStartedEvent) {
+	m.collections.Store(e.RequestID, commandCollection(e.CommandName, e.Command))
+}
+
+func (m *commandMetrics) finished(e event.CommandFinishedEvent, failed bool) {
+	collection := "none"
+	if name, ok := m.collections.LoadAndDelete(e.RequestID); ok {
+		collection = name.(string)
+	}
+	metrics.ObserveMongoOperation(collection, e.CommandName, failed, e.Duration)
+}
+
+// commandCollection returns the collection a command targets: the value of its first element,
+// except for getMore, which names it in its collection field
+func commandCollection(name string, command bson.Raw) string {
+	var field bson.RawValue
+	if name == "getMore" {
+		field = command.Lookup("collection")
+	} else if first, err := command.IndexErr(0); err == nil {
+		field = first.Value()
+	}
+	if collection, ok := field.StringValueOK(); ok {
+		return collection
+	}
+	return "none"
+}
@@ -0,0 +1,207 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// migrationsCollection records the migrations applied to a database
+const migrationsCollection = "schema_migrations"
+
+// Migration is a versioned change to the indexes or the data of the database. Migrations run
+// once, in version order, and must be safe to re-run if the process stops before recording them.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+}
+
+// AppliedMigration is the record kept for a migration once it has run
+type AppliedMigration struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+// Migrator applies pending migrations to a database
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for the given migrations, which may be listed in any order
+func NewMigrator(db *mongo.Database, migrations []Migration) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	return &Migrator{
+		db:         db,
+		migrations: sorted,
+	}
+}
+
+// Applied returns the migrations already recorded in the database, by version
+func (m *Migrator) Applied(ctx context.Context) (map[int]AppliedMigration, error) {
+	cursor, err := m.db.Collection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []AppliedMigration
+	if err = cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]AppliedMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// Pending returns the migrations not yet applied, in version order
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.Applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// CheckApplied reports an error listing the pending migrations, if any
+func (m *Migrator) CheckApplied(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		versions := make([]string, len(pending))
+		for i, migration := range pending {
+			versions[i] = strconv.Itoa(migration.Version)
+		}
+		return fmt.Errorf("pending migrations: %s", strings.Join(versions, ", "))
+	}
+	return nil
+}
+
+// Up applies every pending migration in version order, stopping at the first failure
+func (m *Migrator) Up(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range pending {
+		slog.InfoContext(ctx, "applying migration", slog.Int("version", migration.Version), slog.String("description", migration.Description))
+		if err := migration.Up(ctx, m.db); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+
+		record := AppliedMigration{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   time.Now(),
+		}
+		if _, err := m.db.Collection(migrationsCollection).InsertOne(ctx, record); err != nil && !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("recording migration %d: %w", migration.Version, err)
+		}
+	}
+	return nil
+}
+
+// Migrations lists the schema changes of the application, oldest first
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "unique index on package tracking numbers",
+		Up: createIndexes("packages", mongo.IndexModel{
+			Keys:    bson.D{{Key: "tracking_number", Value: 1}},
+			Options: options.Index().SetName("tracking_number_unique").SetUnique(true),
+		}),
+	},
+	{
+		Version:     2,
+		Description: "indexes on route drivers and stops",
+		Up: createIndexes("routes",
+			mongo.IndexModel{
+				Keys:    bson.D{{Key: "driver_id", Value: 1}, {Key: "date", Value: 1}},
+				Options: options.Index().SetName("driver_id_date"),
+			},
+			mongo.IndexModel{
+				Keys:    bson.D{{Key: "packages.package_id", Value: 1}},
+				Options: options.Index().SetName("packages_package_id"),
+			},
+		),
+	},
+	{
+		Version:     3,
+		Description: "backfill the status of packages stored before statuses existed",
+		Up:          backfillPackageStatus,
+	},
+	{
+		Version:     4,
+		Description: "start the version of drivers, packages and routes stored before versioning",
+		Up:          backfillVersions,
+	},
+}
+
+// createIndexes builds a migration creating indexes on a collection; creating an existing index is a no-op
+func createIndexes(collection string, indexes ...mongo.IndexModel) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes)
+		return err
+	}
+}
+
+// backfillPackageStatus derives a status from the delivered flag that packages carried before the
+// package lifecycle was introduced
+func backfillPackageStatus(ctx context.Context, db *mongo.Database) error {
+	packages := db.Collection("packages")
+	missing := bson.M{"$or": bson.A{
+		bson.M{"status": bson.M{"$exists": false}},
+		bson.M{"status": ""},
+	}}
+
+	delivered := bson.M{"$and": bson.A{missing, bson.M{"delivered": true}}}
+	if _, err := packages.UpdateMany(ctx, delivered, bson.M{"$set": bson.M{"status": models.PackageStatusDelivered}}); err != nil {
+		return err
+	}
+
+	_, err := packages.UpdateMany(ctx, missing, bson.M{"$set": bson.M{"status": models.PackageStatusPending}})
+	return err
+}
+
+// backfillVersions gives documents stored before optimistic concurrency the version new documents start at
+func backfillVersions(ctx context.Context, db *mongo.Database) error {
+	for _, collection := range []string{"drivers", "packages", "routes"} {
+		_, err := db.Collection(collection).UpdateMany(ctx,
+			bson.M{"version": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"version": 1}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
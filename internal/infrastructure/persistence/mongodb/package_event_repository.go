@@ -0,0 +1,49 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+type PackageEventRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPackageEventRepository(db *mongo.Database) *PackageEventRepository {
+	return &PackageEventRepository{
+		collection: db.Collection("package_events"),
+	}
+}
+
+// Append stores a new event; events are never updated or deleted
+func (r *PackageEventRepository) Append(ctx context.Context, event *models.PackageEvent) error {
+	result, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// ListByPackageID returns a package's events in the order they happened
+func (r *PackageEventRepository) ListByPackageID(ctx context.Context, packageID primitive.ObjectID) ([]*models.PackageEvent, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"package_id": packageID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	events := []*models.PackageEvent{}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,96 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type PackageRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPackageRepository(db *mongo.Database) *PackageRepository {
+	return &PackageRepository{
+		collection: db.Collection("packages"),
+	}
+}
+
+func (r *PackageRepository) Create(ctx context.Context, pkg *models.Package) error {
+	pkg.CreatedAt = time.Now()
+	pkg.UpdatedAt = time.Now()
+	pkg.Version = 1
+
+	result, err := r.collection.InsertOne(ctx, pkg)
+	if err != nil {
+		return translateError(err, "package", pkg.TrackingNumber)
+	}
+
+	pkg.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *PackageRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Package, error) {
+	var pkg models.Package
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&pkg)
+	if err != nil {
+		return nil, translateError(err, "package", id.Hex())
+	}
+	return &pkg, nil
+}
+
+func (r *PackageRepository) GetByTrackingNumber(ctx context.Context, trackingNumber string) (*models.Package, error) {
+	var pkg models.Package
+	err := r.collection.FindOne(ctx, bson.M{"tracking_number": trackingNumber}).Decode(&pkg)
+	if err != nil {
+		return nil, translateError(err, "package", trackingNumber)
+	}
+	return &pkg, nil
+}
+
+func (r *PackageRepository) List(ctx context.Context, filter repositories.PackageFilter, page repositories.PageRequest) (*repositories.Page[models.Package], error) {
+	return findPage(ctx, r.collection, packageQuery(filter), page, func(pkg *models.Package) primitive.ObjectID {
+		return pkg.ID
+	})
+}
+
+func (r *PackageRepository) Count(ctx context.Context, filter repositories.PackageFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, packageQuery(filter))
+}
+
+func (r *PackageRepository) Update(ctx context.Context, pkg *models.Package) error {
+	pkg.UpdatedAt = time.Now()
+	pkg.Version++
+
+	if err := replaceVersioned(ctx, r.collection, "package", pkg.ID, pkg.Version-1, pkg); err != nil {
+		pkg.Version--
+		return translateError(err, "package", pkg.TrackingNumber)
+	}
+	return nil
+}
+
+func (r *PackageRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// packageQuery builds the MongoDB filter matching the packages selected by filter
+func packageQuery(filter repositories.PackageFilter) bson.M {
+	query := bson.M{}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.TrackingPrefix != "" {
+		query["tracking_number"] = prefixPattern(filter.TrackingPrefix)
+	}
+	if created := timeRange(filter.CreatedFrom, filter.CreatedTo); created != nil {
+		query["created_at"] = created
+	}
+	return query
+}
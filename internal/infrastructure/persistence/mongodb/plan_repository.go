@@ -0,0 +1,51 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+type PlanRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPlanRepository(db *mongo.Database) *PlanRepository {
+	return &PlanRepository{
+		collection: db.Collection("plans"),
+	}
+}
+
+func (r *PlanRepository) Create(ctx context.Context, plan *models.Plan) error {
+	plan.CreatedAt = time.Now()
+	plan.UpdatedAt = time.Now()
+
+	result, err := r.collection.InsertOne(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	plan.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *PlanRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Plan, error) {
+	var plan models.Plan
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&plan)
+	if err != nil {
+		return nil, translateError(err, "plan", id.Hex())
+	}
+	return &plan, nil
+}
+
+func (r *PlanRepository) Update(ctx context.Context, plan *models.Plan) error {
+	plan.UpdatedAt = time.Now()
+
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": plan.ID}, plan)
+	return err
+}
@@ -0,0 +1,149 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+type RouteRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRouteRepository(db *mongo.Database) *RouteRepository {
+	return &RouteRepository{
+		collection: db.Collection("routes"),
+	}
+}
+
+func (r *RouteRepository) Create(ctx context.Context, route *models.Route) error {
+	route.CreatedAt = time.Now()
+	route.UpdatedAt = time.Now()
+	route.Version = 1
+
+	result, err := r.collection.InsertOne(ctx, route)
+	if err != nil {
+		return err
+	}
+
+	route.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *RouteRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Route, error) {
+	var route models.Route
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&route)
+	if err != nil {
+		return nil, translateError(err, "route", id.Hex())
+	}
+	return &route, nil
+}
+
+func (r *RouteRepository) List(ctx context.Context, filter repositories.RouteFilter, page repositories.PageRequest) (*repositories.Page[models.Route], error) {
+	return findPage(ctx, r.collection, routeQuery(filter), page, func(route *models.Route) primitive.ObjectID {
+		return route.ID
+	})
+}
+
+func (r *RouteRepository) Count(ctx context.Context, filter repositories.RouteFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, routeQuery(filter))
+}
+
+func (r *RouteRepository) Update(ctx context.Context, route *models.Route) error {
+	route.UpdatedAt = time.Now()
+	route.Version++
+
+	if err := replaceVersioned(ctx, r.collection, "route", route.ID, route.Version-1, route); err != nil {
+		route.Version--
+		return err
+	}
+	return nil
+}
+
+func (r *RouteRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *RouteRepository) GetByDriverID(ctx context.Context, driverID primitive.ObjectID) ([]*models.Route, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"driver_id": driverID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var routes []*models.Route
+	if err = cursor.All(ctx, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+func (r *RouteRepository) UpdatePackageStatus(ctx context.Context, route *models.Route, packageID primitive.ObjectID) error {
+	stop := route.Stop(packageID)
+	if stop == nil {
+		return models.NotFoundf("package %s is not on this route", packageID.Hex())
+	}
+
+	// Only the package's stop is rewritten, located by an array filter rather than by its position,
+	// which another writer may have changed by resequencing the route
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{
+			"_id":                 route.ID,
+			"version":             route.Version,
+			"packages.package_id": packageID,
+		},
+		bson.M{
+			"$set": bson.M{
+				"packages.$[stop]": stop,
+				"updated_at":       route.UpdatedAt,
+			},
+			"$inc": bumpVersion,
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []interface{}{bson.M{"stop.package_id": packageID}},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return unmatchedVersion(ctx, r.collection, "route", route.ID, route.Version)
+	}
+
+	route.Version++
+	return nil
+}
+
+// routeQuery builds the MongoDB filter matching the routes selected by filter
+func routeQuery(filter repositories.RouteFilter) bson.M {
+	query := bson.M{}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !filter.DriverID.IsZero() {
+		query["driver_id"] = filter.DriverID
+	}
+	if date := timeRange(filter.DateFrom, filter.DateTo); date != nil {
+		query["date"] = date
+	}
+	if filter.Delivered != nil {
+		// Stops written before failed attempts were recorded have no failed field
+		outstanding := bson.M{"$elemMatch": bson.M{"delivered": false, "failed": bson.M{"$ne": true}}}
+		if *filter.Delivered {
+			query["packages.0"] = bson.M{"$exists": true}
+			query["$nor"] = bson.A{bson.M{"packages": outstanding}}
+		} else {
+			query["packages"] = outstanding
+		}
+	}
+	return query
+}
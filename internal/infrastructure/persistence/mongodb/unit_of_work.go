@@ -0,0 +1,41 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// UnitOfWork runs use cases in MongoDB transactions, which need a replica set or a sharded cluster
+type UnitOfWork struct {
+	client *mongo.Client
+}
+
+func NewUnitOfWork(client *mongo.Client) *UnitOfWork {
+	return &UnitOfWork{
+		client: client,
+	}
+}
+
+// Do runs fn in a transaction, committing it when fn succeeds. A unit started within another one
+// joins the enclosing transaction instead of opening its own.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := u.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	if err != nil {
+		slog.DebugContext(ctx, "transaction rolled back", slog.Any("error", err))
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// replaceVersioned replaces the document with the given ID only while it is stored at version,
+// telling a missing document apart from one changed by another writer
+func replaceVersioned(ctx context.Context, collection *mongo.Collection, entity string, id primitive.ObjectID, version int64, doc any) error {
+	result, err := collection.ReplaceOne(ctx, bson.M{"_id": id, "version": version}, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+	return unmatchedVersion(ctx, collection, entity, id, version)
+}
+
+// unmatchedVersion explains why a write conditioned on version matched no document: either the
+// document is missing or another writer changed it
+func unmatchedVersion(ctx context.Context, collection *mongo.Collection, entity string, id primitive.ObjectID, version int64) error {
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return repositories.NotFound(entity, id.Hex())
+	}
+	slog.WarnContext(ctx, "rejected a write based on a stale version",
+		slog.String("entity", entity),
+		slog.String("id", id.Hex()),
+		slog.Int64("version", version),
+	)
+	return repositories.StaleVersion(entity, id.Hex(), version)
+}
+
+// bumpVersion is the update clause that advances the version of a document changed in place
+var bumpVersion = bson.M{"version": 1}
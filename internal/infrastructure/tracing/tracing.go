@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName identifies this service in the exported spans
+const ServiceName = "delivery-planner"
+
+// Exporters selectable with TRACING_EXPORTER
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Config selects where spans are exported and how many traces are kept
+type Config struct {
+	// Exporter is one of none, otlp, stdout or file. The OTLP exporter sends spans over gRPC and
+	// is configured with the standard OTEL_EXPORTER_OTLP_* variables.
+	Exporter string
+	// File is the path the file exporter appends its JSON spans to
+	File string
+	// SampleRatio is the fraction of new traces recorded; traces started by a caller follow the
+	// caller's sampling decision
+	SampleRatio float64
+	// Environment is reported as the deployment environment of the spans
+	Environment string
+}
+
+// Setup installs the global tracer provider and the W3C trace context propagator, so that incoming
+// traceparent headers and metadata are continued. The returned function flushes the pending spans
+// and releases the exporter.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, closeOutput, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.DeploymentEnvironment(cfg.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeErr := closeOutput(); err == nil {
+			err = closeErr
+		}
+		return err
+	}, nil
+}
+
+// newExporter creates the configured exporter, or none when tracing is disabled, along with the
+// function closing the output it writes to
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		return nil, noClose, nil
+	case ExporterOTLP:
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("OTLP trace exporter: %w", err)
+		}
+		return exporter, noClose, nil
+	case ExporterStdout:
+		exporter, err := newWriterExporter(os.Stdout)
+		return exporter, noClose, err
+	case ExporterFile:
+		file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace file: %w", err)
+		}
+		exporter, err := newWriterExporter(file)
+		if err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+		return exporter, file.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+}
+
+// newWriterExporter creates an exporter writing the spans to w as one JSON document each
+func newWriterExporter(w io.Writer) (sdktrace.SpanExporter, error) {
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return nil, fmt.Errorf("trace writer exporter: %w", err)
+	}
+	return exporter, nil
+}
@@ -0,0 +1,151 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// Domain is reported in the gRPC error details to identify where an error originated
+const Domain = "deliveryplanner"
+
+// internalMessage is all clients learn of an unclassified error, whose text may reveal storage details
+const internalMessage = "internal error"
+
+// Code classifies an error for API clients
+type Code string
+
+const (
+	CodeNotFound          Code = "not_found"
+	CodeConflict          Code = "conflict"
+	CodeInvalidTransition Code = "invalid_transition"
+	CodeValidation        Code = "validation_failed"
+	CodeCapacityExceeded  Code = "capacity_exceeded"
+	CodeInternal          Code = "internal"
+)
+
+// kinds maps each domain error kind to its code, most specific first
+var kinds = []struct {
+	kind error
+	code Code
+}{
+	{models.ErrInvalidTransition, CodeInvalidTransition},
+	{models.ErrCapacityExceeded, CodeCapacityExceeded},
+	{models.ErrValidation, CodeValidation},
+	{models.ErrNotFound, CodeNotFound},
+	{models.ErrConflict, CodeConflict},
+}
+
+var httpStatuses = map[Code]int{
+	CodeNotFound:          http.StatusNotFound,
+	CodeConflict:          http.StatusConflict,
+	CodeInvalidTransition: http.StatusConflict,
+	CodeValidation:        http.StatusBadRequest,
+	CodeCapacityExceeded:  http.StatusUnprocessableEntity,
+	CodeInternal:          http.StatusInternalServerError,
+}
+
+var grpcCodes = map[Code]codes.Code{
+	CodeNotFound:          codes.NotFound,
+	CodeConflict:          codes.FailedPrecondition,
+	CodeInvalidTransition: codes.FailedPrecondition,
+	CodeValidation:        codes.InvalidArgument,
+	CodeCapacityExceeded:  codes.FailedPrecondition,
+	CodeInternal:          codes.Internal,
+}
+
+// Error is the structured description of a failed request shared by the HTTP and gRPC transports
+type Error struct {
+	Code    Code              `json:"code"`
+	Message string            `json:"message"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// FromError classifies err by its domain error kind. Unclassified errors are logged with the
+// request's context and reported as a generic internal error.
+func FromError(ctx context.Context, err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	e := &Error{Code: CodeInternal, Message: internalMessage}
+	for _, k := range kinds {
+		if errors.Is(err, k.kind) {
+			e.Code = k.code
+			e.Message = err.Error()
+			break
+		}
+	}
+	if e.Code == CodeInternal {
+		slog.ErrorContext(ctx, "internal error", slog.Any("error", err))
+		return e
+	}
+
+	var transitionErr *models.TransitionError
+	if errors.As(err, &transitionErr) {
+		e.Details = map[string]string{
+			"entity": transitionErr.Entity,
+			"from":   transitionErr.From,
+			"to":     transitionErr.To,
+		}
+	}
+
+	return e
+}
+
+// Validation builds the error reported for a malformed request
+func Validation(message string) *Error {
+	return &Error{Code: CodeValidation, Message: message}
+}
+
+// Internal builds the generic error reported for a failure whose cause has already been logged
+func Internal() *Error {
+	return &Error{Code: CodeInternal, Message: internalMessage}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// HTTPStatus returns the HTTP status code matching the error
+func (e *Error) HTTPStatus() int {
+	return httpStatuses[e.Code]
+}
+
+// GRPCStatus returns the gRPC status matching the error, with its details attached
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(grpcCodes[e.Code], e.Message)
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   strings.ToUpper(string(e.Code)),
+		Domain:   Domain,
+		Metadata: e.Details,
+	})
+	if err != nil {
+		return st
+	}
+
+	if e.Code == CodeInvalidTransition {
+		violation := &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "STATUS_TRANSITION",
+				Subject:     e.Details["entity"],
+				Description: e.Message,
+			}},
+		}
+		if withViolation, err := withDetails.WithDetails(violation); err == nil {
+			return withViolation
+		}
+	}
+
+	return withDetails
+}
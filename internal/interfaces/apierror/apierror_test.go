@@ -0,0 +1,95 @@
+package apierror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    Code
+		wantMessage string
+		wantStatus  int
+		wantGRPC    codes.Code
+	}{
+		{name: "not found", err: models.NotFoundf("route %s not found", "r1"), wantCode: CodeNotFound, wantStatus: http.StatusNotFound, wantGRPC: codes.NotFound},
+		{name: "conflict", err: models.ErrPlanAlreadyConfirmed, wantCode: CodeConflict, wantStatus: http.StatusConflict, wantGRPC: codes.FailedPrecondition},
+		{name: "validation", err: models.Validationf("invalid latitude"), wantCode: CodeValidation, wantStatus: http.StatusBadRequest, wantGRPC: codes.InvalidArgument},
+		{name: "invalid transition", err: &models.TransitionError{Entity: "route", From: "completed", To: "active"}, wantCode: CodeInvalidTransition, wantStatus: http.StatusConflict, wantGRPC: codes.FailedPrecondition},
+		{name: "capacity exceeded", err: fmt.Errorf("adding packages: %w", models.ErrCapacityExceeded), wantCode: CodeCapacityExceeded, wantStatus: http.StatusUnprocessableEntity, wantGRPC: codes.FailedPrecondition},
+		{name: "wrapped domain error", err: fmt.Errorf("loading route: %w", models.NotFoundf("route not found")), wantCode: CodeNotFound, wantStatus: http.StatusNotFound, wantGRPC: codes.NotFound},
+		{name: "unclassified error", err: errors.New("connection reset"), wantCode: CodeInternal, wantMessage: "internal error", wantStatus: http.StatusInternalServerError, wantGRPC: codes.Internal},
+		{name: "malformed request", err: Validation("invalid route ID"), wantCode: CodeValidation, wantStatus: http.StatusBadRequest, wantGRPC: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantMessage := tt.wantMessage
+			if wantMessage == "" {
+				wantMessage = tt.err.Error()
+			}
+
+			got := FromError(context.Background(), tt.err)
+			if got.Code != tt.wantCode || got.Message != wantMessage {
+				t.Errorf("FromError = %+v, want code %s with message %q", got, tt.wantCode, wantMessage)
+			}
+			if status := got.HTTPStatus(); status != tt.wantStatus {
+				t.Errorf("HTTPStatus = %d, want %d", status, tt.wantStatus)
+			}
+			if code := got.GRPCStatus().Code(); code != tt.wantGRPC {
+				t.Errorf("GRPCStatus code = %s, want %s", code, tt.wantGRPC)
+			}
+		})
+	}
+}
+
+func TestFromError_TransitionDetails(t *testing.T) {
+	got := FromError(context.Background(), &models.TransitionError{Entity: "package", From: "pending", To: "delivered"})
+
+	want := map[string]string{"entity": "package", "from": "pending", "to": "delivered"}
+	for key, value := range want {
+		if got.Details[key] != value {
+			t.Errorf("details[%s] = %q, want %q", key, got.Details[key], value)
+		}
+	}
+	if n := len(got.GRPCStatus().Details()); n != 2 {
+		t.Errorf("gRPC status carries %d details, want the error info and the precondition failure", n)
+	}
+}
+
+func TestFromError_LogsInternalErrors(t *testing.T) {
+	var logs bytes.Buffer
+	logger, err := logging.New(&logs, "info")
+	if err != nil {
+		t.Fatalf("logging.New: %v", err)
+	}
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logger)
+
+	ctx := logging.WithRequestID(context.Background(), "req-42")
+	got := FromError(ctx, fmt.Errorf("find routes: %w", errors.New("server selection timeout on mongo-0:27017")))
+	if got.Message != "internal error" {
+		t.Errorf("FromError message = %q, want the storage error hidden", got.Message)
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("log %q is not one JSON record: %v", logs.String(), err)
+	}
+	if record[logging.RequestIDKey] != "req-42" || record["error"] != "find routes: server selection timeout on mongo-0:27017" {
+		t.Errorf("log record = %v, want the error with the request ID", record)
+	}
+}
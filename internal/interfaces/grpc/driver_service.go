@@ -10,6 +10,7 @@ import (
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 	"github.com/Arcanm/deliveryPlannerGolang/proto"
 )
 
@@ -28,10 +29,14 @@ func NewDriverService(service *services.DriverService) *DriverService {
 
 // CreateDriver creates a new driver
 func (s *DriverService) CreateDriver(ctx context.Context, req *proto.CreateDriverRequest) (*proto.CreateDriverResponse, error) {
-	vehicleType := models.VehicleType(req.VehicleType.String())
-	driver, err := s.service.CreateDriver(ctx, req.Name, vehicleType)
+	vehicleType, ok := convertVehicleTypeFromProto(req.VehicleType)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid vehicle type: %v", req.VehicleType)
+	}
+
+	driver, err := s.service.CreateDriver(ctx, req.Name, vehicleType, convertCapacityFromProto(req.CapacityOverride))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create driver: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.CreateDriverResponse{
@@ -48,7 +53,7 @@ func (s *DriverService) GetDriver(ctx context.Context, req *proto.GetDriverReque
 
 	driver, err := s.service.GetDriver(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "driver not found: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.GetDriverResponse{
@@ -56,20 +61,30 @@ func (s *DriverService) GetDriver(ctx context.Context, req *proto.GetDriverReque
 	}, nil
 }
 
-// ListDrivers retrieves all drivers
+// ListDrivers retrieves one page of the drivers matching the request filters
 func (s *DriverService) ListDrivers(ctx context.Context, req *proto.ListDriversRequest) (*proto.ListDriversResponse, error) {
-	drivers, err := s.service.ListDrivers(ctx)
+	filter := repositories.DriverFilter{Active: req.Active}
+	if req.VehicleType != proto.VehicleType_VEHICLE_TYPE_UNSPECIFIED {
+		vehicleType, ok := convertVehicleTypeFromProto(req.VehicleType)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid vehicle type: %v", req.VehicleType)
+		}
+		filter.VehicleType = vehicleType
+	}
+
+	page, err := s.service.ListDrivers(ctx, filter, convertPageRequestFromProto(req.PageSize, req.PageToken, req.Descending))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list drivers: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
-	protoDrivers := make([]*proto.Driver, len(drivers))
-	for i, driver := range drivers {
+	protoDrivers := make([]*proto.Driver, len(page.Items))
+	for i, driver := range page.Items {
 		protoDrivers[i] = convertDriverToProto(driver)
 	}
 
 	return &proto.ListDriversResponse{
-		Drivers: protoDrivers,
+		Drivers:       protoDrivers,
+		NextPageToken: page.NextCursor,
 	}, nil
 }
 
@@ -80,10 +95,14 @@ func (s *DriverService) UpdateDriver(ctx context.Context, req *proto.UpdateDrive
 		return nil, status.Errorf(codes.InvalidArgument, "invalid driver id: %v", err)
 	}
 
-	vehicleType := models.VehicleType(req.VehicleType.String())
-	driver, err := s.service.UpdateDriver(ctx, id, req.Name, vehicleType, req.Active)
+	vehicleType, ok := convertVehicleTypeFromProto(req.VehicleType)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid vehicle type: %v", req.VehicleType)
+	}
+
+	driver, err := s.service.UpdateDriver(ctx, id, req.Version, req.Name, vehicleType, req.Active, convertCapacityFromProto(req.CapacityOverride))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update driver: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.UpdateDriverResponse{
@@ -99,7 +118,7 @@ func (s *DriverService) DeleteDriver(ctx context.Context, req *proto.DeleteDrive
 	}
 
 	if err := s.service.DeleteDriver(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete driver: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.DeleteDriverResponse{}, nil
@@ -114,7 +133,7 @@ func (s *DriverService) GetDriverRoutes(ctx context.Context, req *proto.GetDrive
 
 	routes, err := s.service.GetDriverRoutes(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get driver routes: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	protoRoutes := make([]*proto.Route, len(routes))
@@ -134,12 +153,56 @@ func convertDriverToProto(driver *models.Driver) *proto.Driver {
 	}
 
 	return &proto.Driver{
-		Id:          driver.ID.Hex(),
-		Name:        driver.Name,
-		VehicleType: proto.VehicleType(proto.VehicleType_value[string(driver.VehicleType)]),
-		Active:      driver.Active,
-		CreatedAt:   timestamppb.New(driver.CreatedAt),
-		UpdatedAt:   timestamppb.New(driver.UpdatedAt),
+		Id:               driver.ID.Hex(),
+		Name:             driver.Name,
+		VehicleType:      convertVehicleTypeToProto(driver.VehicleType),
+		Active:           driver.Active,
+		CreatedAt:        timestamppb.New(driver.CreatedAt),
+		UpdatedAt:        timestamppb.New(driver.UpdatedAt),
+		CapacityOverride: convertCapacityToProto(driver.CapacityOverride),
+		Version:          driver.Version,
+	}
+}
+
+// vehicleTypeToProto maps the domain vehicle types onto the proto enum
+var vehicleTypeToProto = map[models.VehicleType]proto.VehicleType{
+	models.VehicleTypeBike:  proto.VehicleType_VEHICLE_TYPE_BIKE,
+	models.VehicleTypeVan:   proto.VehicleType_VEHICLE_TYPE_VAN,
+	models.VehicleTypeTruck: proto.VehicleType_VEHICLE_TYPE_TRUCK,
+}
+
+func convertVehicleTypeToProto(vehicleType models.VehicleType) proto.VehicleType {
+	return vehicleTypeToProto[vehicleType]
+}
+
+func convertVehicleTypeFromProto(vehicleType proto.VehicleType) (models.VehicleType, bool) {
+	for domainType, protoType := range vehicleTypeToProto {
+		if protoType == vehicleType {
+			return domainType, true
+		}
+	}
+	return "", false
+}
+
+func convertCapacityToProto(capacity *models.VehicleCapacity) *proto.VehicleCapacity {
+	if capacity == nil {
+		return nil
+	}
+
+	return &proto.VehicleCapacity{
+		MaxWeightKg: capacity.MaxWeightKg,
+		MaxVolumeM3: capacity.MaxVolumeM3,
+	}
+}
+
+func convertCapacityFromProto(capacity *proto.VehicleCapacity) *models.VehicleCapacity {
+	if capacity == nil {
+		return nil
+	}
+
+	return &models.VehicleCapacity{
+		MaxWeightKg: capacity.MaxWeightKg,
+		MaxVolumeM3: capacity.MaxVolumeM3,
 	}
 }
 
@@ -150,19 +213,31 @@ func convertRouteToProto(route *models.Route) *proto.Route {
 			PackageId:         pkg.PackageID.Hex(),
 			OrderInRoute:      int32(pkg.OrderInRoute),
 			Delivered:         pkg.Delivered,
-			DeliveryTimestamp: timestamppb.New(*pkg.DeliveryTimestamp),
+			DeliveryTimestamp: convertOptionalTimeToProto(pkg.DeliveryTimestamp),
+			PlannedArrival:    convertOptionalTimeToProto(pkg.PlannedArrival),
+			OutsideTimeWindow: pkg.OutsideTimeWindow,
+			ProjectedArrival:  convertOptionalTimeToProto(pkg.ProjectedArrival),
+			Failed:            pkg.Failed,
+			FailureReason:     convertFailureReasonToProto(pkg.FailureReason),
 		}
 	}
 
 	return &proto.Route{
-		Id:                  route.ID.Hex(),
-		DriverId:            route.DriverID.Hex(),
-		Date:                timestamppb.New(route.Date),
-		Packages:            protoPackages,
-		EstimatedDistanceKm: float32(route.EstimatedDistanceKm),
-		EstimatedTimeMin:    int32(route.EstimatedTimeMin),
-		Completed:           route.Status == models.RouteStatusCompleted,
-		CreatedAt:           timestamppb.New(route.CreatedAt),
-		UpdatedAt:           timestamppb.New(route.UpdatedAt),
+		Id:                   route.ID.Hex(),
+		DriverId:             route.DriverID.Hex(),
+		Date:                 timestamppb.New(route.Date),
+		Packages:             protoPackages,
+		EstimatedDistanceKm:  float32(route.EstimatedDistanceKm),
+		EstimatedTimeMin:     int32(route.EstimatedTimeMin),
+		Completed:            route.Status == models.RouteStatusCompleted,
+		CreatedAt:            timestamppb.New(route.CreatedAt),
+		UpdatedAt:            timestamppb.New(route.UpdatedAt),
+		StartLocation:        convertLocationToProto(route.StartLocation),
+		LoadWeightKg:         route.LoadWeightKg,
+		LoadVolumeM3:         route.LoadVolumeM3,
+		WeightUtilizationPct: route.WeightUtilizationPct,
+		VolumeUtilizationPct: route.VolumeUtilizationPct,
+		Status:               convertRouteStatusToProto(route.Status),
+		Version:              route.Version,
 	}
 }
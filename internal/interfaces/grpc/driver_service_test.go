@@ -0,0 +1,219 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/proto"
+)
+
+func TestDriverService_CreateDriver(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *proto.CreateDriverRequest
+		wantCode codes.Code
+	}{
+		{name: "valid driver", req: &proto.CreateDriverRequest{Name: "Ann", VehicleType: proto.VehicleType_VEHICLE_TYPE_VAN}, wantCode: codes.OK},
+		{name: "with capacity override", req: &proto.CreateDriverRequest{Name: "Ann", VehicleType: proto.VehicleType_VEHICLE_TYPE_BIKE, CapacityOverride: &proto.VehicleCapacity{MaxWeightKg: 20, MaxVolumeM3: 0.2}}, wantCode: codes.OK},
+		{name: "invalid capacity override", req: &proto.CreateDriverRequest{Name: "Ann", VehicleType: proto.VehicleType_VEHICLE_TYPE_VAN, CapacityOverride: &proto.VehicleCapacity{MaxWeightKg: -1}}, wantCode: codes.InvalidArgument},
+		{name: "unspecified vehicle type", req: &proto.CreateDriverRequest{Name: "Ann"}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			resp, err := s.drivers.CreateDriver(context.Background(), tt.req)
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if resp.Driver.Id == "" || resp.Driver.VehicleType != tt.req.VehicleType || !resp.Driver.Active {
+				t.Errorf("driver = %v, want a stored active driver", resp.Driver)
+			}
+		})
+	}
+}
+
+func TestDriverService_GetDriver(t *testing.T) {
+	s := newTestServer(t)
+	driver := s.createDriver(t)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "existing driver", id: driver.ID.Hex(), wantCode: codes.OK},
+		{name: "unknown driver", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.drivers.GetDriver(context.Background(), &proto.GetDriverRequest{Id: tt.id})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Driver.VehicleType != proto.VehicleType_VEHICLE_TYPE_VAN {
+				t.Errorf("vehicle type = %s, want van", resp.Driver.VehicleType)
+			}
+		})
+	}
+}
+
+func TestDriverService_ListDrivers(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name      string
+		req       *proto.ListDriversRequest
+		wantCode  codes.Code
+		wantCount int
+		wantMore  bool
+	}{
+		{name: "no filter", req: &proto.ListDriversRequest{}, wantCode: codes.OK, wantCount: 2},
+		{name: "by vehicle type", req: &proto.ListDriversRequest{VehicleType: proto.VehicleType_VEHICLE_TYPE_TRUCK}, wantCode: codes.OK, wantCount: 0},
+		{name: "inactive drivers", req: &proto.ListDriversRequest{Active: &inactive}, wantCode: codes.OK, wantCount: 0},
+		{name: "first page", req: &proto.ListDriversRequest{PageSize: 1}, wantCode: codes.OK, wantCount: 1, wantMore: true},
+		{name: "malformed page token", req: &proto.ListDriversRequest{PageToken: "nope"}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createDriver(t)
+			s.createDriver(t)
+
+			resp, err := s.drivers.ListDrivers(context.Background(), tt.req)
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(resp.Drivers) != tt.wantCount || (resp.NextPageToken != "") != tt.wantMore {
+				t.Errorf("listed %d drivers with next page token %q, want %d and more %v", len(resp.Drivers), resp.NextPageToken, tt.wantCount, tt.wantMore)
+			}
+		})
+	}
+}
+
+func TestDriverService_UpdateDriver(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		version  int64
+		wantCode codes.Code
+	}{
+		{name: "existing driver", wantCode: codes.OK},
+		{name: "current version", version: 1, wantCode: codes.OK},
+		{name: "mismatched version", version: 2, wantCode: codes.FailedPrecondition},
+		{name: "unknown driver", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createDriver(t).ID.Hex()
+			}
+
+			resp, err := s.drivers.UpdateDriver(context.Background(), &proto.UpdateDriverRequest{Id: id, Name: "Renamed", VehicleType: proto.VehicleType_VEHICLE_TYPE_TRUCK, Version: tt.version})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if resp.Driver.Name != "Renamed" || resp.Driver.VehicleType != proto.VehicleType_VEHICLE_TYPE_TRUCK || resp.Driver.Active || resp.Driver.Version != 2 {
+				t.Errorf("driver = %v, want the updated fields at version 2", resp.Driver)
+			}
+		})
+	}
+}
+
+func TestDriverService_DeleteDriver(t *testing.T) {
+	tests := []struct {
+		name        string
+		activeRoute bool
+		id          string
+		wantCode    codes.Code
+	}{
+		{name: "idle driver", wantCode: codes.OK},
+		{name: "driver on an active route", activeRoute: true, wantCode: codes.FailedPrecondition},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createDriver(t).ID.Hex()
+			}
+			if tt.activeRoute {
+				route, _ := s.createActiveRoute(t)
+				id = route.DriverID.Hex()
+			}
+
+			_, err := s.drivers.DeleteDriver(context.Background(), &proto.DeleteDriverRequest{Id: id})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestDriverService_GetDriverRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		deliver bool
+	}{
+		{name: "route with an undelivered package"},
+		{name: "route with a delivered package", deliver: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, pkg := s.createActiveRoute(t)
+			if tt.deliver {
+				if _, err := s.routeService.UpdatePackageDeliveryStatus(context.Background(), route.ID, pkg.ID, true, nil); err != nil {
+					t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+				}
+			}
+
+			resp, err := s.drivers.GetDriverRoutes(context.Background(), &proto.GetDriverRoutesRequest{DriverId: route.DriverID.Hex()})
+			wantCode(t, err, codes.OK)
+			if len(resp.Routes) != 1 || len(resp.Routes[0].Packages) != 1 {
+				t.Fatalf("routes = %v, want one route with one stop", resp.Routes)
+			}
+			if stop := resp.Routes[0].Packages[0]; stop.Delivered != tt.deliver || (stop.DeliveryTimestamp != nil) != tt.deliver {
+				t.Errorf("stop = %v, want delivered %v with a matching timestamp", stop, tt.deliver)
+			}
+		})
+	}
+
+	t.Run("malformed id", func(t *testing.T) {
+		s := newTestServer(t)
+		_, err := s.drivers.GetDriverRoutes(context.Background(), &proto.GetDriverRoutesRequest{DriverId: "nope"})
+		wantCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// Routes created over gRPC for a driver created over gRPC must size the vehicle from its type
+func TestDriverService_VehicleTypeRoundTrip(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	resp, err := s.drivers.CreateDriver(ctx, &proto.CreateDriverRequest{Name: "Ann", VehicleType: proto.VehicleType_VEHICLE_TYPE_BIKE})
+	wantCode(t, err, codes.OK)
+	id, _ := primitive.ObjectIDFromHex(resp.Driver.Id)
+
+	driver, err := s.driverService.GetDriver(ctx, id)
+	if err != nil {
+		t.Fatalf("GetDriver: %v", err)
+	}
+	if driver.VehicleType != models.VehicleTypeBike {
+		t.Errorf("stored vehicle type = %q, want %q", driver.VehicleType, models.VehicleTypeBike)
+	}
+}
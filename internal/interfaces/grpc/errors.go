@@ -0,0 +1,12 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/apierror"
+)
+
+// statusError converts a domain error into the gRPC status matching its kind, with its details attached
+func statusError(ctx context.Context, err error) error {
+	return apierror.FromError(ctx, err).GRPCStatus().Err()
+}
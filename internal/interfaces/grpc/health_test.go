@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// waitForStatus polls the health service until it reports want for the whole server
+func (s *testServer) waitForStatus(t *testing.T, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		if resp.GetStatus() == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("status = %s, want %s", resp.GetStatus(), want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHealthService(t *testing.T) {
+	s := newTestServer(t)
+	var mongoDown atomic.Bool
+	s.checker.AddCheck("mongodb", func(context.Context) error {
+		if mongoDown.Load() {
+			return errors.New("server selection timeout")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.checker.Run(ctx)
+	}()
+
+	s.waitForStatus(t, healthpb.HealthCheckResponse_SERVING)
+
+	mongoDown.Store(true)
+	s.waitForStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	mongoDown.Store(false)
+	s.waitForStatus(t, healthpb.HealthCheckResponse_SERVING)
+
+	// Cancelling the checker starts the drain, which holds whatever the checks report
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	s.waitForStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/health"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/memory"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/storage/filesystem"
+	"github.com/Arcanm/deliveryPlannerGolang/proto"
+)
+
+// spans records every span ended by the tests; the global provider can only be installed once
+var spans = tracetest.NewSpanRecorder()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// testServer serves the three gRPC services over an in-memory connection, as cmd/main.go wires them
+type testServer struct {
+	drivers  proto.DriverServiceClient
+	packages proto.PackageServiceClient
+	routes   proto.RouteServiceClient
+	health   healthpb.HealthClient
+
+	checker        *health.Checker
+	driverService  *services.DriverService
+	packageService *services.PackageService
+	routeService   *services.RouteService
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	blobs, err := filesystem.NewBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewBlobStore: %v", err)
+	}
+
+	driverRepo := memory.NewDriverRepository()
+	packageRepo := memory.NewPackageRepository()
+	routeRepo := memory.NewRouteRepository()
+	eventRepo := memory.NewPackageEventRepository()
+
+	grpcHealth := grpchealth.NewServer()
+	s := &testServer{checker: health.NewChecker(grpcHealth, 10*time.Millisecond)}
+	s.driverService = services.NewDriverService(driverRepo, routeRepo)
+	s.packageService = services.NewPackageService(packageRepo, eventRepo, blobs)
+	s.routeService = services.NewRouteService(routeRepo, driverRepo, packageRepo, eventRepo, memory.NewUnitOfWork(), blobs, optimization.NewDefaultOptimizer(), models.DefaultVehicleCapacities, 3, 8*time.Hour)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(RequestIDUnaryInterceptor, LoggingUnaryInterceptor, MetricsUnaryInterceptor, ActorUnaryInterceptor),
+		grpc.ChainStreamInterceptor(RequestIDStreamInterceptor, LoggingStreamInterceptor, MetricsStreamInterceptor),
+	)
+	proto.RegisterDriverServiceServer(server, NewDriverService(s.driverService))
+	proto.RegisterPackageServiceServer(server, NewPackageService(s.packageService, s.routeService))
+	proto.RegisterRouteServiceServer(server, NewRouteService(s.routeService))
+	healthpb.RegisterHealthServer(server, grpcHealth)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	s.drivers = proto.NewDriverServiceClient(conn)
+	s.packages = proto.NewPackageServiceClient(conn)
+	s.routes = proto.NewRouteServiceClient(conn)
+	s.health = healthpb.NewHealthClient(conn)
+	return s
+}
+
+var testDepot = &models.Location{Latitude: 40.4168, Longitude: -3.7038}
+
+// testDate is the delivery day used by every fixture
+var testDate = time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)
+
+// wantCode fails the test unless err carries the expected gRPC status code
+func wantCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("code = %s, want %s (error: %v)", got, want, err)
+	}
+}
+
+func (s *testServer) createDriver(t *testing.T) *models.Driver {
+	t.Helper()
+
+	driver, err := s.driverService.CreateDriver(context.Background(), "Ann", models.VehicleTypeVan, nil)
+	if err != nil {
+		t.Fatalf("CreateDriver: %v", err)
+	}
+	return driver
+}
+
+func (s *testServer) createPackage(t *testing.T) *models.Package {
+	t.Helper()
+
+	location := &models.Location{Latitude: testDepot.Latitude + 0.01, Longitude: testDepot.Longitude}
+	pkg, err := s.packageService.CreatePackage(context.Background(), primitive.NewObjectID().Hex(), "Customer", "Street 1", "600000000", 1, 0.01, location, nil, 5)
+	if err != nil {
+		t.Fatalf("CreatePackage: %v", err)
+	}
+	return pkg
+}
+
+// createRoute creates a pending route for a new driver loaded with one undelivered package
+func (s *testServer) createRoute(t *testing.T) (*models.Route, *models.Package) {
+	t.Helper()
+	ctx := context.Background()
+
+	route, err := s.routeService.CreateRoute(ctx, s.createDriver(t).ID, testDate, testDepot)
+	if err != nil {
+		t.Fatalf("CreateRoute: %v", err)
+	}
+	pkg := s.createPackage(t)
+	if err := s.routeService.AddPackagesToRoute(ctx, route.ID, []primitive.ObjectID{pkg.ID}); err != nil {
+		t.Fatalf("AddPackagesToRoute: %v", err)
+	}
+	return route, pkg
+}
+
+// createActiveRoute creates a route with one package and starts it
+func (s *testServer) createActiveRoute(t *testing.T) (*models.Route, *models.Package) {
+	t.Helper()
+
+	route, pkg := s.createRoute(t)
+	if err := s.routeService.UpdateRouteStatus(context.Background(), route.ID, models.RouteStatusActive); err != nil {
+		t.Fatalf("UpdateRouteStatus: %v", err)
+	}
+	return route, pkg
+}
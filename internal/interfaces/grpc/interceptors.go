@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+)
+
+// actorMetadataKey identifies the caller responsible for a change
+const actorMetadataKey = "x-actor"
+
+// requestIDMetadataKey correlates a call with the logs it produces, here and in the caller
+const requestIDMetadataKey = "x-request-id"
+
+// ActorUnaryInterceptor attributes the changes made by a call to the actor named in its x-actor metadata
+func ActorUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(actorMetadataKey); len(values) > 0 {
+			ctx = services.WithActor(ctx, values[0])
+		}
+	}
+	return handler(ctx, req)
+}
+
+// RequestIDUnaryInterceptor propagates the caller's x-request-id metadata, or generates one, into
+// the call context and echoes it in the response headers
+func RequestIDUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+// RequestIDStreamInterceptor is the streaming counterpart of RequestIDUnaryInterceptor
+func RequestIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+}
+
+// LoggingUnaryInterceptor logs each call once it has been served
+func LoggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// LoggingStreamInterceptor logs each stream once it has been closed
+func LoggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+// MetricsUnaryInterceptor records the count, status code and latency of each call
+func MetricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.ObserveGRPCCall(info.FullMethod, status.Code(err).String(), time.Since(start))
+	return resp, err
+}
+
+// MetricsStreamInterceptor is the streaming counterpart of MetricsUnaryInterceptor
+func MetricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	metrics.ObserveGRPCCall(info.FullMethod, status.Code(err).String(), time.Since(start))
+	return err
+}
+
+// withRequestID adds the call's request ID to its context and response headers
+func withRequestID(ctx context.Context) context.Context {
+	var incoming string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+			incoming = values[0]
+		}
+	}
+
+	id := logging.RequestID(incoming)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, id))
+	return logging.WithRequestID(ctx, id)
+}
+
+// logCall logs a served call with its outcome; server-side failures are logged as errors
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	}
+	slog.Log(ctx, level, "grpc call",
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
+
+// contextStream is a server stream whose handlers see a derived context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+	"github.com/Arcanm/deliveryPlannerGolang/proto"
+)
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		wantID string
+	}{
+		{name: "propagates the caller's ID", md: metadata.Pairs(requestIDMetadataKey, "req-42"), wantID: "req-42"},
+		{name: "generates a missing ID"},
+		{name: "replaces an unsafe ID", md: metadata.Pairs(requestIDMetadataKey, "req 42 forged")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			var logs bytes.Buffer
+			logger, err := logging.New(&logs, "info")
+			if err != nil {
+				t.Fatalf("logging.New: %v", err)
+			}
+			defer slog.SetDefault(slog.Default())
+			slog.SetDefault(logger)
+
+			pkg := s.createPackage(t)
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewOutgoingContext(ctx, tt.md)
+			}
+
+			var header metadata.MD
+			_, err = s.packages.UpdatePackageStatus(ctx, &proto.UpdatePackageStatusRequest{Id: pkg.ID.Hex(), Status: proto.PackageStatus_PACKAGE_STATUS_CANCELLED}, grpc.Header(&header))
+			wantCode(t, err, codes.OK)
+
+			values := header.Get(requestIDMetadataKey)
+			if len(values) != 1 || tt.wantID != "" && values[0] != tt.wantID || tt.wantID == "" && len(values[0]) != 32 {
+				t.Fatalf("%s header = %v, want %q or a generated ID", requestIDMetadataKey, values, tt.wantID)
+			}
+
+			// Both the call log and the service log carry the request ID
+			messages := map[string]bool{}
+			for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+				var record map[string]any
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("log line %q is not JSON: %v", line, err)
+				}
+				if record[logging.RequestIDKey] == values[0] {
+					messages[record["msg"].(string)] = true
+				}
+			}
+			if !messages["grpc call"] || !messages["package status changed"] {
+				t.Errorf("records with request ID %q = %v, want the call and the service logs", values[0], messages)
+			}
+		})
+	}
+}
+
+func TestTracingStatsHandler(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		wantContinued bool
+	}{
+		{name: "continues the caller's trace", md: metadata.Pairs("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01"), wantContinued: true},
+		{name: "starts a trace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			driver := s.createDriver(t)
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewOutgoingContext(ctx, tt.md)
+			}
+			before := len(spans.Ended())
+
+			_, err := s.drivers.GetDriver(ctx, &proto.GetDriverRequest{Id: driver.ID.Hex()})
+			wantCode(t, err, codes.OK)
+
+			// The call span is the parent of the service span
+			ended := spans.Ended()[before:]
+			var call, service sdktrace.ReadOnlySpan
+			for _, span := range ended {
+				switch span.Name() {
+				case "deliveryplanner.DriverService/GetDriver":
+					call = span
+				case "DriverService.GetDriver":
+					service = span
+				}
+			}
+			if call == nil || service == nil || service.Parent().SpanID() != call.SpanContext().SpanID() {
+				t.Fatalf("spans = %v, want a call span parent of DriverService.GetDriver", ended)
+			}
+			if continued := call.Parent().IsValid(); continued != tt.wantContinued {
+				t.Errorf("call span continues a trace = %v, want %v", continued, tt.wantContinued)
+			}
+			if tt.wantContinued && service.SpanContext().TraceID().String() != traceID {
+				t.Errorf("trace ID = %s, want the caller's %s", service.SpanContext().TraceID(), traceID)
+			}
+		})
+	}
+}
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       func(driver *models.Driver) string
+		wantCode codes.Code
+	}{
+		{name: "counts successful calls", id: func(driver *models.Driver) string { return driver.ID.Hex() }, wantCode: codes.OK},
+		{name: "counts failed calls by code", id: func(*models.Driver) string { return primitive.NewObjectID().Hex() }, wantCode: codes.NotFound},
+		{name: "counts rejected calls", id: func(*models.Driver) string { return "not-an-id" }, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			driver := s.createDriver(t)
+			series := `grpc_requests_total{code="` + tt.wantCode.String() + `",method="/deliveryplanner.DriverService/GetDriver"}`
+			before := scrape(t, series)
+
+			_, err := s.drivers.GetDriver(context.Background(), &proto.GetDriverRequest{Id: tt.id(driver)})
+			wantCode(t, err, tt.wantCode)
+
+			if got := scrape(t, series); got != before+1 {
+				t.Errorf("%s = %v, want %v", series, got, before+1)
+			}
+		})
+	}
+}
+
+// scrape reads the current value of a series from the /metrics exposition, 0 when absent
+func scrape(t *testing.T, series string) float64 {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, series+" "); ok {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatalf("parse %q: %v", line, err)
+			}
+			return v
+		}
+	}
+	return 0
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 	"github.com/Arcanm/deliveryPlannerGolang/proto"
 )
 
@@ -30,9 +31,9 @@ func NewPackageService(service *services.PackageService, routeService *services.
 
 // CreatePackage creates a new package
 func (s *PackageService) CreatePackage(ctx context.Context, req *proto.CreatePackageRequest) (*proto.CreatePackageResponse, error) {
-	pkg, err := s.service.CreatePackage(ctx, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, float64(req.WeightKg), float64(req.VolumeM3))
+	pkg, err := s.service.CreatePackage(ctx, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, float64(req.WeightKg), float64(req.VolumeM3), convertLocationFromProto(req.Location), convertTimeWindowFromProto(req.DeliveryWindow), int(req.ServiceDurationMin))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create package: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.CreatePackageResponse{
@@ -49,7 +50,7 @@ func (s *PackageService) GetPackage(ctx context.Context, req *proto.GetPackageRe
 
 	pkg, err := s.service.GetPackage(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "package not found: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.GetPackageResponse{
@@ -61,10 +62,7 @@ func (s *PackageService) GetPackage(ctx context.Context, req *proto.GetPackageRe
 func (s *PackageService) GetPackageByTrackingNumber(ctx context.Context, req *proto.GetPackageByTrackingNumberRequest) (*proto.GetPackageByTrackingNumberResponse, error) {
 	pkg, err := s.service.GetPackageByTrackingNumber(ctx, req.TrackingNumber)
 	if err != nil {
-		return nil, err
-	}
-	if pkg == nil {
-		return nil, nil
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.GetPackageByTrackingNumberResponse{
@@ -72,20 +70,34 @@ func (s *PackageService) GetPackageByTrackingNumber(ctx context.Context, req *pr
 	}, nil
 }
 
-// ListPackages retrieves all packages
+// ListPackages retrieves one page of the packages matching the request filters
 func (s *PackageService) ListPackages(ctx context.Context, req *proto.ListPackagesRequest) (*proto.ListPackagesResponse, error) {
-	packages, err := s.service.ListPackages(ctx)
+	filter := repositories.PackageFilter{
+		TrackingPrefix: req.TrackingPrefix,
+		CreatedFrom:    convertOptionalTimeFromProto(req.CreatedFrom),
+		CreatedTo:      convertOptionalTimeFromProto(req.CreatedTo),
+	}
+	if req.Status != proto.PackageStatus_PACKAGE_STATUS_UNSPECIFIED {
+		packageStatus, ok := convertPackageStatusFromProto(req.Status)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid package status: %v", req.Status)
+		}
+		filter.Status = packageStatus
+	}
+
+	page, err := s.service.ListPackages(ctx, filter, convertPageRequestFromProto(req.PageSize, req.PageToken, req.Descending))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list packages: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
-	protoPackages := make([]*proto.Package, len(packages))
-	for i, pkg := range packages {
+	protoPackages := make([]*proto.Package, len(page.Items))
+	for i, pkg := range page.Items {
 		protoPackages[i] = convertPackageToProto(pkg)
 	}
 
 	return &proto.ListPackagesResponse{
-		Packages: protoPackages,
+		Packages:      protoPackages,
+		NextPageToken: page.NextCursor,
 	}, nil
 }
 
@@ -96,9 +108,9 @@ func (s *PackageService) UpdatePackage(ctx context.Context, req *proto.UpdatePac
 		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
 	}
 
-	pkg, err := s.service.UpdatePackage(ctx, id, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, float64(req.WeightKg), float64(req.VolumeM3))
+	pkg, err := s.service.UpdatePackage(ctx, id, req.Version, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, float64(req.WeightKg), float64(req.VolumeM3), convertLocationFromProto(req.Location), convertTimeWindowFromProto(req.DeliveryWindow), int(req.ServiceDurationMin))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update package: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.UpdatePackageResponse{
@@ -110,12 +122,17 @@ func (s *PackageService) UpdatePackage(ctx context.Context, req *proto.UpdatePac
 func (s *PackageService) UpdatePackageStatus(ctx context.Context, req *proto.UpdatePackageStatusRequest) (*proto.UpdatePackageStatusResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
 	}
 
-	pkg, err := s.service.MarkAsDelivered(ctx, id)
+	packageStatus, ok := convertPackageStatusFromProto(req.Status)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package status: %v", req.Status)
+	}
+
+	pkg, err := s.service.UpdatePackageStatus(ctx, id, packageStatus, convertLocationFromProto(req.Location), req.Note)
 	if err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.UpdatePackageStatusResponse{
@@ -131,7 +148,7 @@ func (s *PackageService) DeletePackage(ctx context.Context, req *proto.DeletePac
 	}
 
 	if err := s.service.DeletePackage(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete package: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.DeletePackageResponse{}, nil
@@ -150,22 +167,22 @@ func (s *PackageService) AssignToRoute(ctx context.Context, req *proto.AssignToR
 	}
 
 	if err := s.routeService.AddPackagesToRoute(ctx, routeID, []primitive.ObjectID{packageID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to assign package to route: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.AssignToRouteResponse{}, nil
 }
 
-// MarkPackageAsDelivered marks a package as delivered
+// MarkPackageAsDelivered marks a package as delivered at its route stop
 func (s *PackageService) MarkPackageAsDelivered(ctx context.Context, req *proto.MarkPackageAsDeliveredRequest) (*proto.MarkPackageAsDeliveredResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
 	}
 
-	pkg, err := s.service.MarkAsDelivered(ctx, id)
+	pkg, err := s.routeService.DeliverPackage(ctx, id, convertDeliveryProofFromProto(req.Proof))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to mark package as delivered: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.MarkPackageAsDeliveredResponse{
@@ -182,18 +199,18 @@ func (s *PackageService) GetPackagesByRoute(ctx context.Context, req *proto.GetP
 
 	route, err := s.routeService.GetRoute(ctx, routeID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get route: %v", err)
+		return nil, statusError(ctx, err)
 	}
 
 	packages := make([]*models.Package, len(route.Packages))
 	for i, pkg := range route.Packages {
 		packageID, err := primitive.ObjectIDFromHex(pkg.PackageID.Hex())
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "invalid package id in route: %v", err)
+			return nil, statusError(ctx, err)
 		}
 		pkg, err := s.service.GetPackage(ctx, packageID)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get package: %v", err)
+			return nil, statusError(ctx, err)
 		}
 		packages[i] = pkg
 	}
@@ -208,6 +225,45 @@ func (s *PackageService) GetPackagesByRoute(ctx context.Context, req *proto.GetP
 	}, nil
 }
 
+// GetPackageHistory retrieves the tracking events of a package
+func (s *PackageService) GetPackageHistory(ctx context.Context, req *proto.GetPackageHistoryRequest) (*proto.GetPackageHistoryResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
+	}
+
+	events, err := s.service.GetPackageHistory(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	protoEvents := make([]*proto.PackageEvent, len(events))
+	for i, event := range events {
+		protoEvents[i] = convertPackageEventToProto(event)
+	}
+
+	return &proto.GetPackageHistoryResponse{
+		Events: protoEvents,
+	}, nil
+}
+
+// GetProofOfDelivery retrieves the proof captured when a package was delivered
+func (s *PackageService) GetProofOfDelivery(ctx context.Context, req *proto.GetProofOfDeliveryRequest) (*proto.GetProofOfDeliveryResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
+	}
+
+	proof, err := s.service.GetProofOfDelivery(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &proto.GetProofOfDeliveryResponse{
+		Proof: convertDeliveryProofToProto(proof),
+	}, nil
+}
+
 // Helper functions to convert between domain and proto models
 func convertPackageToProto(pkg *models.Package) *proto.Package {
 	if pkg == nil {
@@ -220,16 +276,189 @@ func convertPackageToProto(pkg *models.Package) *proto.Package {
 	}
 
 	return &proto.Package{
-		Id:                pkg.ID.Hex(),
-		TrackingNumber:    pkg.TrackingNumber,
-		CustomerName:      pkg.CustomerName,
-		CustomerAddress:   pkg.CustomerAddress,
-		CustomerPhone:     pkg.CustomerPhone,
-		WeightKg:          float64(pkg.WeightKg),
-		VolumeM3:          float64(pkg.VolumeM3),
-		Delivered:         pkg.Delivered,
-		DeliveryTimestamp: deliveryTimestamp,
-		CreatedAt:         timestamppb.New(pkg.CreatedAt),
-		UpdatedAt:         timestamppb.New(pkg.UpdatedAt),
+		Id:                 pkg.ID.Hex(),
+		TrackingNumber:     pkg.TrackingNumber,
+		CustomerName:       pkg.CustomerName,
+		CustomerAddress:    pkg.CustomerAddress,
+		CustomerPhone:      pkg.CustomerPhone,
+		WeightKg:           float64(pkg.WeightKg),
+		VolumeM3:           float64(pkg.VolumeM3),
+		Delivered:          pkg.Delivered,
+		DeliveryTimestamp:  deliveryTimestamp,
+		CreatedAt:          timestamppb.New(pkg.CreatedAt),
+		UpdatedAt:          timestamppb.New(pkg.UpdatedAt),
+		Location:           convertLocationToProto(pkg.Location),
+		DeliveryWindow:     convertTimeWindowToProto(pkg.DeliveryWindow),
+		ServiceDurationMin: int32(pkg.ServiceDurationMin),
+		Status:             convertPackageStatusToProto(pkg.CurrentStatus()),
+		FailedAttempts:     int32(pkg.FailedAttempts),
+		LastFailure:        convertDeliveryFailureToProto(pkg.LastFailure),
+		Version:            pkg.Version,
+	}
+}
+
+// packageStatusToProto maps the domain package lifecycle onto the proto enum
+var packageStatusToProto = map[models.PackageStatus]proto.PackageStatus{
+	models.PackageStatusPending:        proto.PackageStatus_PACKAGE_STATUS_PENDING,
+	models.PackageStatusAssigned:       proto.PackageStatus_PACKAGE_STATUS_ASSIGNED,
+	models.PackageStatusOutForDelivery: proto.PackageStatus_PACKAGE_STATUS_OUT_FOR_DELIVERY,
+	models.PackageStatusDelivered:      proto.PackageStatus_PACKAGE_STATUS_DELIVERED,
+	models.PackageStatusFailed:         proto.PackageStatus_PACKAGE_STATUS_FAILED,
+	models.PackageStatusReturned:       proto.PackageStatus_PACKAGE_STATUS_RETURNED,
+	models.PackageStatusCancelled:      proto.PackageStatus_PACKAGE_STATUS_CANCELLED,
+}
+
+func convertPackageStatusToProto(packageStatus models.PackageStatus) proto.PackageStatus {
+	return packageStatusToProto[packageStatus]
+}
+
+func convertPackageStatusFromProto(packageStatus proto.PackageStatus) (models.PackageStatus, bool) {
+	for domainStatus, protoStatus := range packageStatusToProto {
+		if protoStatus == packageStatus {
+			return domainStatus, true
+		}
+	}
+	return "", false
+}
+
+func convertLocationToProto(location *models.Location) *proto.Location {
+	if location == nil {
+		return nil
+	}
+
+	return &proto.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+func convertLocationFromProto(location *proto.Location) *models.Location {
+	if location == nil {
+		return nil
+	}
+
+	return &models.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+func convertTimeWindowToProto(window *models.TimeWindow) *proto.TimeWindow {
+	if window == nil {
+		return nil
+	}
+
+	return &proto.TimeWindow{
+		Earliest: timestamppb.New(window.Earliest),
+		Latest:   timestamppb.New(window.Latest),
+	}
+}
+
+func convertTimeWindowFromProto(window *proto.TimeWindow) *models.TimeWindow {
+	if window == nil {
+		return nil
+	}
+
+	return &models.TimeWindow{
+		Earliest: window.Earliest.AsTime(),
+		Latest:   window.Latest.AsTime(),
+	}
+}
+
+func convertPackageEventToProto(event *models.PackageEvent) *proto.PackageEvent {
+	protoEvent := &proto.PackageEvent{
+		Id:         event.ID.Hex(),
+		PackageId:  event.PackageID.Hex(),
+		Type:       string(event.Type),
+		Actor:      event.Actor,
+		FromStatus: convertPackageStatusToProto(event.FromStatus),
+		ToStatus:   convertPackageStatusToProto(event.ToStatus),
+		Location:   convertLocationToProto(event.Location),
+		Note:       event.Note,
+		Timestamp:  timestamppb.New(event.Timestamp),
+	}
+	if event.RouteID != nil {
+		protoEvent.RouteId = event.RouteID.Hex()
+	}
+	return protoEvent
+}
+
+// failureReasonToProto maps the domain failure reasons onto the proto enum
+var failureReasonToProto = map[models.FailureReason]proto.DeliveryFailureReason{
+	models.FailureReasonCustomerNotHome:  proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_CUSTOMER_NOT_HOME,
+	models.FailureReasonAddressNotFound:  proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_ADDRESS_NOT_FOUND,
+	models.FailureReasonRefused:          proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_REFUSED,
+	models.FailureReasonDamaged:          proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_DAMAGED,
+	models.FailureReasonAccessRestricted: proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_ACCESS_RESTRICTED,
+	models.FailureReasonOther:            proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_OTHER,
+}
+
+func convertFailureReasonToProto(reason models.FailureReason) proto.DeliveryFailureReason {
+	return failureReasonToProto[reason]
+}
+
+func convertFailureReasonFromProto(reason proto.DeliveryFailureReason) (models.FailureReason, bool) {
+	for domainReason, protoReason := range failureReasonToProto {
+		if protoReason == reason {
+			return domainReason, true
+		}
+	}
+	return "", false
+}
+
+func convertDeliveryFailureToProto(failure *models.DeliveryFailure) *proto.DeliveryFailure {
+	if failure == nil {
+		return nil
+	}
+	return &proto.DeliveryFailure{
+		Reason:   convertFailureReasonToProto(failure.Reason),
+		Note:     failure.Note,
+		Attempt:  int32(failure.Attempt),
+		FailedAt: timestamppb.New(failure.FailedAt),
+	}
+}
+
+func convertDeliveryProofFromProto(proof *proto.ProofOfDelivery) *services.DeliveryProof {
+	if proof == nil {
+		return nil
+	}
+	return &services.DeliveryProof{
+		RecipientName: proof.RecipientName,
+		Location:      convertLocationFromProto(proof.Location),
+		Signature:     convertAttachmentFromProto(proof.Signature),
+		Photo:         convertAttachmentFromProto(proof.Photo),
+	}
+}
+
+func convertDeliveryProofToProto(proof *services.DeliveryProof) *proto.ProofOfDelivery {
+	if proof == nil {
+		return nil
+	}
+	return &proto.ProofOfDelivery{
+		RecipientName: proof.RecipientName,
+		Location:      convertLocationToProto(proof.Location),
+		Signature:     convertAttachmentToProto(proof.Signature),
+		Photo:         convertAttachmentToProto(proof.Photo),
+		CapturedAt:    timestamppb.New(proof.CapturedAt),
+	}
+}
+
+func convertAttachmentFromProto(attachment *proto.Attachment) *services.Attachment {
+	if attachment == nil {
+		return nil
+	}
+	return &services.Attachment{
+		ContentType: attachment.ContentType,
+		Data:        attachment.Data,
+	}
+}
+
+func convertAttachmentToProto(attachment *services.Attachment) *proto.Attachment {
+	if attachment == nil {
+		return nil
+	}
+	return &proto.Attachment{
+		ContentType: attachment.ContentType,
+		Data:        attachment.Data,
 	}
 }
@@ -0,0 +1,404 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/proto"
+)
+
+func validCreatePackageRequest() *proto.CreatePackageRequest {
+	return &proto.CreatePackageRequest{
+		TrackingNumber:  "TRK-1",
+		CustomerName:    "Customer",
+		CustomerAddress: "Street 1",
+		CustomerPhone:   "600000000",
+		WeightKg:        2,
+		VolumeM3:        0.02,
+		Location:        &proto.Location{Latitude: 40.42, Longitude: -3.70},
+	}
+}
+
+func TestPackageService_CreatePackage(t *testing.T) {
+	invalidLocation := validCreatePackageRequest()
+	invalidLocation.Location = &proto.Location{Latitude: 120}
+
+	tests := []struct {
+		name     string
+		req      *proto.CreatePackageRequest
+		wantCode codes.Code
+	}{
+		{name: "valid package", req: validCreatePackageRequest(), wantCode: codes.OK},
+		{name: "invalid location", req: invalidLocation, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			resp, err := s.packages.CreatePackage(context.Background(), tt.req)
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && (resp.Package.Id == "" || resp.Package.Status != proto.PackageStatus_PACKAGE_STATUS_PENDING) {
+				t.Errorf("package = %v, want a stored pending package", resp.Package)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackage(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "existing package", id: pkg.ID.Hex(), wantCode: codes.OK},
+		{name: "unknown package", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.packages.GetPackage(context.Background(), &proto.GetPackageRequest{Id: tt.id})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestPackageService_GetPackageByTrackingNumber(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	tests := []struct {
+		name           string
+		trackingNumber string
+		wantCode       codes.Code
+	}{
+		{name: "existing package", trackingNumber: pkg.TrackingNumber, wantCode: codes.OK},
+		{name: "unknown tracking number", trackingNumber: "TRK-404", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.packages.GetPackageByTrackingNumber(context.Background(), &proto.GetPackageByTrackingNumberRequest{TrackingNumber: tt.trackingNumber})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Package.Id != pkg.ID.Hex() {
+				t.Errorf("package id = %s, want %s", resp.Package.Id, pkg.ID.Hex())
+			}
+		})
+	}
+}
+
+func TestPackageService_ListPackages(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *proto.ListPackagesRequest
+		wantCount int
+	}{
+		{name: "no filter", req: &proto.ListPackagesRequest{}, wantCount: 2},
+		{name: "by status", req: &proto.ListPackagesRequest{Status: proto.PackageStatus_PACKAGE_STATUS_DELIVERED}, wantCount: 0},
+		{name: "by tracking prefix", req: &proto.ListPackagesRequest{TrackingPrefix: "none-"}, wantCount: 0},
+		{name: "newest first", req: &proto.ListPackagesRequest{Descending: true, PageSize: 1}, wantCount: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createPackage(t)
+			s.createPackage(t)
+
+			resp, err := s.packages.ListPackages(context.Background(), tt.req)
+			wantCode(t, err, codes.OK)
+			if len(resp.Packages) != tt.wantCount {
+				t.Errorf("listed %d packages, want %d", len(resp.Packages), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPackageService_UpdatePackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "existing package", wantCode: codes.OK},
+		{name: "unknown package", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createPackage(t).ID.Hex()
+			}
+
+			create := validCreatePackageRequest()
+			resp, err := s.packages.UpdatePackage(context.Background(), &proto.UpdatePackageRequest{
+				Id:              id,
+				TrackingNumber:  "TRK-2",
+				CustomerName:    create.CustomerName,
+				CustomerAddress: create.CustomerAddress,
+				CustomerPhone:   create.CustomerPhone,
+				WeightKg:        create.WeightKg,
+				VolumeM3:        create.VolumeM3,
+				Location:        create.Location,
+			})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Package.TrackingNumber != "TRK-2" {
+				t.Errorf("tracking number = %s, want TRK-2", resp.Package.TrackingNumber)
+			}
+		})
+	}
+}
+
+func TestPackageService_UpdatePackageStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   proto.PackageStatus
+		wantCode codes.Code
+	}{
+		{name: "allowed transition", status: proto.PackageStatus_PACKAGE_STATUS_CANCELLED, wantCode: codes.OK},
+		{name: "forbidden transition", status: proto.PackageStatus_PACKAGE_STATUS_DELIVERED, wantCode: codes.FailedPrecondition},
+		{name: "unspecified status", status: proto.PackageStatus_PACKAGE_STATUS_UNSPECIFIED, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			pkg := s.createPackage(t)
+
+			resp, err := s.packages.UpdatePackageStatus(context.Background(), &proto.UpdatePackageStatusRequest{Id: pkg.ID.Hex(), Status: tt.status, Note: "requested"})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Package.Status != tt.status {
+				t.Errorf("status = %s, want %s", resp.Package.Status, tt.status)
+			}
+		})
+	}
+}
+
+// Rejected transitions carry the machine-readable reason and the statuses involved
+func TestPackageService_UpdatePackageStatus_ErrorDetails(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	_, err := s.packages.UpdatePackageStatus(context.Background(), &proto.UpdatePackageStatusRequest{Id: pkg.ID.Hex(), Status: proto.PackageStatus_PACKAGE_STATUS_DELIVERED})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	var info *errdetails.ErrorInfo
+	var violation *errdetails.PreconditionFailure
+	for _, detail := range status.Convert(err).Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.PreconditionFailure:
+			violation = d
+		}
+	}
+	if info == nil || info.Reason != "INVALID_TRANSITION" || info.Metadata["from"] != "pending" || info.Metadata["to"] != "delivered" {
+		t.Errorf("error info = %v, want an INVALID_TRANSITION reason from pending to delivered", info)
+	}
+	if violation == nil || len(violation.Violations) != 1 || violation.Violations[0].Subject != "package" {
+		t.Errorf("precondition failure = %v, want one violation on the package", violation)
+	}
+}
+
+func TestPackageService_DeletePackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "existing package", wantCode: codes.OK},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createPackage(t).ID.Hex()
+			}
+
+			_, err := s.packages.DeletePackage(context.Background(), &proto.DeletePackageRequest{Id: id})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestPackageService_AssignToRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    bool
+		routeID  string
+		wantCode codes.Code
+	}{
+		{name: "pending route", wantCode: codes.OK},
+		{name: "route already started", start: true, wantCode: codes.FailedPrecondition},
+		{name: "malformed route id", routeID: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			if tt.start {
+				route, _ = s.createActiveRoute(t)
+			}
+			routeID := tt.routeID
+			if routeID == "" {
+				routeID = route.ID.Hex()
+			}
+
+			_, err := s.packages.AssignToRoute(context.Background(), &proto.AssignToRouteRequest{PackageId: s.createPackage(t).ID.Hex(), RouteId: routeID})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestPackageService_MarkPackageAsDelivered(t *testing.T) {
+	tests := []struct {
+		name     string
+		offRoute bool
+		proof    *proto.ProofOfDelivery
+		wantCode codes.Code
+	}{
+		{name: "without proof", wantCode: codes.OK},
+		{name: "with proof", proof: &proto.ProofOfDelivery{RecipientName: "Bob", Signature: &proto.Attachment{ContentType: "image/png", Data: []byte("signature")}}, wantCode: codes.OK},
+		{name: "proof without recipient", proof: &proto.ProofOfDelivery{}, wantCode: codes.InvalidArgument},
+		{name: "package not on a route", offRoute: true, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			ctx := context.Background()
+			route, pkg := s.createActiveRoute(t)
+			if tt.offRoute {
+				pkg = s.createPackage(t)
+			}
+
+			resp, err := s.packages.MarkPackageAsDelivered(ctx, &proto.MarkPackageAsDeliveredRequest{Id: pkg.ID.Hex(), Proof: tt.proof})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if !resp.Package.Delivered || resp.Package.DeliveryTimestamp == nil {
+				t.Errorf("package = %v, want it delivered with a timestamp", resp.Package)
+			}
+
+			// The delivery is recorded against the route, whose stop is delivered too
+			history, err := s.packages.GetPackageHistory(ctx, &proto.GetPackageHistoryRequest{Id: pkg.ID.Hex()})
+			wantCode(t, err, codes.OK)
+			if last := history.Events[len(history.Events)-1]; last.ToStatus != proto.PackageStatus_PACKAGE_STATUS_DELIVERED || last.RouteId != route.ID.Hex() {
+				t.Errorf("last event = %v, want the delivery on route %s", last, route.ID.Hex())
+			}
+			_, err = s.routes.UpdateRouteStatus(ctx, &proto.UpdateRouteStatusRequest{Id: route.ID.Hex(), Status: proto.RouteStatus_ROUTE_STATUS_COMPLETED})
+			wantCode(t, err, codes.OK)
+
+			proof, err := s.packages.GetProofOfDelivery(ctx, &proto.GetProofOfDeliveryRequest{Id: pkg.ID.Hex()})
+			if tt.proof == nil {
+				wantCode(t, err, codes.NotFound)
+				return
+			}
+			wantCode(t, err, codes.OK)
+			if proof.Proof.RecipientName != "Bob" || string(proof.Proof.Signature.GetData()) != "signature" {
+				t.Errorf("proof = %v, want the captured recipient and signature", proof.Proof)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackagesByRoute(t *testing.T) {
+	s := newTestServer(t)
+	route, pkg := s.createRoute(t)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "existing route", id: route.ID.Hex(), wantCode: codes.OK},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.packages.GetPackagesByRoute(context.Background(), &proto.GetPackagesByRouteRequest{RouteId: tt.id})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && (len(resp.Packages) != 1 || resp.Packages[0].Id != pkg.ID.Hex()) {
+				t.Errorf("packages = %v, want the route's package", resp.Packages)
+			}
+		})
+	}
+}
+
+func TestPackageService_GetPackageHistory(t *testing.T) {
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		wantActor string
+	}{
+		{name: "actor metadata", md: metadata.Pairs(actorMetadataKey, "dispatcher-7"), wantActor: "dispatcher-7"},
+		{name: "no actor metadata", wantActor: services.SystemActor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewOutgoingContext(ctx, tt.md)
+			}
+
+			created, err := s.packages.CreatePackage(ctx, validCreatePackageRequest())
+			wantCode(t, err, codes.OK)
+
+			resp, err := s.packages.GetPackageHistory(context.Background(), &proto.GetPackageHistoryRequest{Id: created.Package.Id})
+			wantCode(t, err, codes.OK)
+			if len(resp.Events) != 1 || resp.Events[0].Actor != tt.wantActor {
+				t.Errorf("events = %v, want one created by %q", resp.Events, tt.wantActor)
+			}
+		})
+	}
+
+	t.Run("unknown package", func(t *testing.T) {
+		s := newTestServer(t)
+		_, err := s.packages.GetPackageHistory(context.Background(), &proto.GetPackageHistoryRequest{Id: primitive.NewObjectID().Hex()})
+		wantCode(t, err, codes.NotFound)
+	})
+}
+
+func TestPackageService_GetProofOfDelivery(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "not delivered", id: pkg.ID.Hex(), wantCode: codes.NotFound},
+		{name: "malformed id", id: "nope", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.packages.GetProofOfDelivery(context.Background(), &proto.GetProofOfDeliveryRequest{Id: tt.id})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
@@ -2,12 +2,16 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 	"github.com/Arcanm/deliveryPlannerGolang/proto"
 )
 
@@ -28,13 +32,13 @@ func NewRouteService(service *services.RouteService) *RouteService {
 func (s *RouteService) CreateRoute(ctx context.Context, req *proto.CreateRouteRequest) (*proto.CreateRouteResponse, error) {
 	driverID, err := primitive.ObjectIDFromHex(req.DriverId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid driver id: %v", err)
 	}
 
 	date := req.Date.AsTime()
-	route, err := s.service.CreateRoute(ctx, driverID, date)
+	route, err := s.service.CreateRoute(ctx, driverID, date, convertLocationFromProto(req.StartLocation))
 	if err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.CreateRouteResponse{
@@ -46,15 +50,12 @@ func (s *RouteService) CreateRoute(ctx context.Context, req *proto.CreateRouteRe
 func (s *RouteService) GetRoute(ctx context.Context, req *proto.GetRouteRequest) (*proto.GetRouteResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
 	route, err := s.service.GetRoute(ctx, id)
 	if err != nil {
-		return nil, err
-	}
-	if route == nil {
-		return nil, nil
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.GetRouteResponse{
@@ -62,20 +63,58 @@ func (s *RouteService) GetRoute(ctx context.Context, req *proto.GetRouteRequest)
 	}, nil
 }
 
-// ListRoutes retrieves all routes
+// GetRouteETA retrieves a route with the projected arrival at each remaining stop
+func (s *RouteService) GetRouteETA(ctx context.Context, req *proto.GetRouteETARequest) (*proto.GetRouteETAResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
+	}
+
+	route, err := s.service.GetRouteETA(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &proto.GetRouteETAResponse{
+		Route: convertRouteToProtoResponse(route),
+	}, nil
+}
+
+// ListRoutes retrieves one page of the routes matching the request filters
 func (s *RouteService) ListRoutes(ctx context.Context, req *proto.ListRoutesRequest) (*proto.ListRoutesResponse, error) {
-	routes, err := s.service.ListRoutes(ctx)
+	filter := repositories.RouteFilter{
+		DateFrom:  convertOptionalTimeFromProto(req.DateFrom),
+		DateTo:    convertOptionalTimeFromProto(req.DateTo),
+		Delivered: req.Delivered,
+	}
+	if req.Status != proto.RouteStatus_ROUTE_STATUS_UNSPECIFIED {
+		routeStatus, ok := convertRouteStatusFromProto(req.Status)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid route status: %v", req.Status)
+		}
+		filter.Status = routeStatus
+	}
+	if req.DriverId != "" {
+		driverID, err := primitive.ObjectIDFromHex(req.DriverId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid driver id: %v", err)
+		}
+		filter.DriverID = driverID
+	}
+
+	page, err := s.service.ListRoutes(ctx, filter, convertPageRequestFromProto(req.PageSize, req.PageToken, req.Descending))
 	if err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
-	protoRoutes := make([]*proto.Route, len(routes))
-	for i, route := range routes {
+	protoRoutes := make([]*proto.Route, len(page.Items))
+	for i, route := range page.Items {
 		protoRoutes[i] = convertRouteToProtoResponse(route)
 	}
 
 	return &proto.ListRoutesResponse{
-		Routes: protoRoutes,
+		Routes:        protoRoutes,
+		NextPageToken: page.NextCursor,
 	}, nil
 }
 
@@ -83,29 +122,20 @@ func (s *RouteService) ListRoutes(ctx context.Context, req *proto.ListRoutesRequ
 func (s *RouteService) UpdateRoute(ctx context.Context, req *proto.UpdateRouteRequest) (*proto.UpdateRouteResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
-	route, err := s.service.GetRoute(ctx, id)
+	driverID, err := primitive.ObjectIDFromHex(req.DriverId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid driver id: %v", err)
 	}
-	if route == nil {
-		return nil, nil
+	if req.Date == nil {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
 	}
 
-	driverID, err := primitive.ObjectIDFromHex(req.DriverId)
+	route, err := s.service.UpdateRoute(ctx, id, req.Version, driverID, req.Date.AsTime())
 	if err != nil {
-		return nil, err
-	}
-
-	route.DriverID = driverID
-	route.Date = req.Date.AsTime()
-	route.EstimatedDistanceKm = float64(req.EstimatedDistanceKm)
-	route.EstimatedTimeMin = int(req.EstimatedTimeMin)
-
-	if err := s.service.UpdateRoute(ctx, route); err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.UpdateRouteResponse{
@@ -117,20 +147,45 @@ func (s *RouteService) UpdateRoute(ctx context.Context, req *proto.UpdateRouteRe
 func (s *RouteService) MarkRouteAsCompleted(ctx context.Context, req *proto.MarkRouteAsCompletedRequest) (*proto.MarkRouteAsCompletedResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
+	}
+
+	if err := s.service.UpdateRouteStatus(ctx, id, models.RouteStatusCompleted); err != nil {
+		return nil, statusError(ctx, err)
 	}
 
 	route, err := s.service.GetRoute(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
-	route.Status = models.RouteStatusCompleted
-	if err := s.service.UpdateRoute(ctx, route); err != nil {
-		return nil, err
+	return &proto.MarkRouteAsCompletedResponse{
+		Route: convertRouteToProtoResponse(route),
+	}, nil
+}
+
+// UpdateRouteStatus moves a route to a new status
+func (s *RouteService) UpdateRouteStatus(ctx context.Context, req *proto.UpdateRouteStatusRequest) (*proto.UpdateRouteStatusResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
-	return &proto.MarkRouteAsCompletedResponse{
+	routeStatus, ok := convertRouteStatusFromProto(req.Status)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route status: %v", req.Status)
+	}
+
+	if err := s.service.UpdateRouteStatus(ctx, id, routeStatus); err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	route, err := s.service.GetRoute(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &proto.UpdateRouteStatusResponse{
 		Route: convertRouteToProtoResponse(route),
 	}, nil
 }
@@ -139,53 +194,102 @@ func (s *RouteService) MarkRouteAsCompleted(ctx context.Context, req *proto.Mark
 func (s *RouteService) AddPackagesToRoute(ctx context.Context, req *proto.AddPackagesToRouteRequest) (*proto.AddPackagesToRouteResponse, error) {
 	routeID, err := primitive.ObjectIDFromHex(req.RouteId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
 	packageIDs := make([]primitive.ObjectID, len(req.PackageIds))
 	for i, id := range req.PackageIds {
 		packageID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
 		}
 		packageIDs[i] = packageID
 	}
 
 	if err := s.service.AddPackagesToRoute(ctx, routeID, packageIDs); err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.AddPackagesToRouteResponse{}, nil
 }
 
-// UpdatePackageDeliveryStatus updates a package's delivery status in a route
+// OptimizeRoute re-sequences the stops of a route
+func (s *RouteService) OptimizeRoute(ctx context.Context, req *proto.OptimizeRouteRequest) (*proto.OptimizeRouteResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
+	}
+
+	result, err := s.service.OptimizeRoute(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &proto.OptimizeRouteResponse{
+		Route:            convertRouteToProtoResponse(result.Route),
+		DistanceBeforeKm: result.DistanceBeforeKm,
+		DistanceAfterKm:  result.DistanceAfterKm,
+	}, nil
+}
+
+// UpdatePackageDeliveryStatus updates a package's delivery status in a route and returns the updated route
 func (s *RouteService) UpdatePackageDeliveryStatus(ctx context.Context, req *proto.UpdatePackageDeliveryStatusRequest) (*proto.UpdatePackageDeliveryStatusResponse, error) {
 	routeID, err := primitive.ObjectIDFromHex(req.RouteId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
+	}
+
+	packageID, err := primitive.ObjectIDFromHex(req.PackageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
+	}
+
+	route, err := s.service.UpdatePackageDeliveryStatus(ctx, routeID, packageID, req.Delivered, convertDeliveryProofFromProto(req.Proof))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &proto.UpdatePackageDeliveryStatusResponse{
+		Route: convertRouteToProtoResponse(route),
+	}, nil
+}
+
+// RecordFailedDeliveryAttempt records a failed delivery attempt at a route stop
+func (s *RouteService) RecordFailedDeliveryAttempt(ctx context.Context, req *proto.RecordFailedDeliveryAttemptRequest) (*proto.RecordFailedDeliveryAttemptResponse, error) {
+	routeID, err := primitive.ObjectIDFromHex(req.RouteId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
 	packageID, err := primitive.ObjectIDFromHex(req.PackageId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package id: %v", err)
+	}
+
+	reason, ok := convertFailureReasonFromProto(req.Reason)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid failure reason: %v", req.Reason)
 	}
 
-	if err := s.service.UpdatePackageDeliveryStatus(ctx, routeID, packageID, req.Delivered); err != nil {
-		return nil, err
+	pkg, err := s.service.RecordFailedDeliveryAttempt(ctx, routeID, packageID, reason, req.Note)
+	if err != nil {
+		return nil, statusError(ctx, err)
 	}
 
-	return &proto.UpdatePackageDeliveryStatusResponse{}, nil
+	return &proto.RecordFailedDeliveryAttemptResponse{
+		Package: convertPackageToProto(pkg),
+	}, nil
 }
 
 // DeleteRoute deletes a route
 func (s *RouteService) DeleteRoute(ctx context.Context, req *proto.DeleteRouteRequest) (*proto.DeleteRouteResponse, error) {
 	id, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route id: %v", err)
 	}
 
 	if err := s.service.DeleteRoute(ctx, id); err != nil {
-		return nil, err
+		return nil, statusError(ctx, err)
 	}
 
 	return &proto.DeleteRouteResponse{}, nil
@@ -203,19 +307,77 @@ func convertRouteToProtoResponse(route *models.Route) *proto.Route {
 			PackageId:         pkg.PackageID.Hex(),
 			OrderInRoute:      int32(pkg.OrderInRoute),
 			Delivered:         pkg.Delivered,
-			DeliveryTimestamp: timestamppb.New(*pkg.DeliveryTimestamp),
+			DeliveryTimestamp: convertOptionalTimeToProto(pkg.DeliveryTimestamp),
+			PlannedArrival:    convertOptionalTimeToProto(pkg.PlannedArrival),
+			OutsideTimeWindow: pkg.OutsideTimeWindow,
+			ProjectedArrival:  convertOptionalTimeToProto(pkg.ProjectedArrival),
+			Failed:            pkg.Failed,
+			FailureReason:     convertFailureReasonToProto(pkg.FailureReason),
 		}
 	}
 
 	return &proto.Route{
-		Id:                  route.ID.Hex(),
-		DriverId:            route.DriverID.Hex(),
-		Date:                timestamppb.New(route.Date),
-		Packages:            packages,
-		EstimatedDistanceKm: float32(route.EstimatedDistanceKm),
-		EstimatedTimeMin:    int32(route.EstimatedTimeMin),
-		Completed:           route.Status == models.RouteStatusCompleted,
-		CreatedAt:           timestamppb.New(route.CreatedAt),
-		UpdatedAt:           timestamppb.New(route.UpdatedAt),
+		Id:                   route.ID.Hex(),
+		DriverId:             route.DriverID.Hex(),
+		Date:                 timestamppb.New(route.Date),
+		Packages:             packages,
+		EstimatedDistanceKm:  float32(route.EstimatedDistanceKm),
+		EstimatedTimeMin:     int32(route.EstimatedTimeMin),
+		Completed:            route.Status == models.RouteStatusCompleted,
+		CreatedAt:            timestamppb.New(route.CreatedAt),
+		UpdatedAt:            timestamppb.New(route.UpdatedAt),
+		StartLocation:        convertLocationToProto(route.StartLocation),
+		LoadWeightKg:         route.LoadWeightKg,
+		LoadVolumeM3:         route.LoadVolumeM3,
+		WeightUtilizationPct: route.WeightUtilizationPct,
+		VolumeUtilizationPct: route.VolumeUtilizationPct,
+		Status:               convertRouteStatusToProto(route.Status),
+		Version:              route.Version,
+	}
+}
+
+func convertOptionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// routeStatusToProto maps the domain route lifecycle onto the proto enum
+var routeStatusToProto = map[models.RouteStatus]proto.RouteStatus{
+	models.RouteStatusPending:   proto.RouteStatus_ROUTE_STATUS_PENDING,
+	models.RouteStatusActive:    proto.RouteStatus_ROUTE_STATUS_ACTIVE,
+	models.RouteStatusCompleted: proto.RouteStatus_ROUTE_STATUS_COMPLETED,
+	models.RouteStatusCancelled: proto.RouteStatus_ROUTE_STATUS_CANCELLED,
+	models.RouteStatusSuspended: proto.RouteStatus_ROUTE_STATUS_SUSPENDED,
+}
+
+// convertOptionalTimeFromProto returns the zero time, meaning unbounded, for an unset timestamp
+func convertOptionalTimeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// convertPageRequestFromProto reads the paging fields shared by the List requests
+func convertPageRequestFromProto(pageSize int32, pageToken string, descending bool) repositories.PageRequest {
+	return repositories.PageRequest{
+		Cursor:     pageToken,
+		Limit:      int(pageSize),
+		Descending: descending,
+	}
+}
+
+func convertRouteStatusToProto(routeStatus models.RouteStatus) proto.RouteStatus {
+	return routeStatusToProto[routeStatus]
+}
+
+func convertRouteStatusFromProto(routeStatus proto.RouteStatus) (models.RouteStatus, bool) {
+	for domainStatus, protoStatus := range routeStatusToProto {
+		if protoStatus == routeStatus {
+			return domainStatus, true
+		}
 	}
+	return "", false
 }
@@ -0,0 +1,346 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Arcanm/deliveryPlannerGolang/proto"
+)
+
+func TestRouteService_CreateRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		driverID func(s *testServer) string
+		wantCode codes.Code
+	}{
+		{name: "active driver", driverID: func(s *testServer) string { return s.createDriver(t).ID.Hex() }, wantCode: codes.OK},
+		{name: "unknown driver", driverID: func(*testServer) string { return primitive.NewObjectID().Hex() }, wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			resp, err := s.routes.CreateRoute(context.Background(), &proto.CreateRouteRequest{
+				DriverId:      tt.driverID(s),
+				Date:          timestamppb.New(testDate),
+				StartLocation: &proto.Location{Latitude: testDepot.Latitude, Longitude: testDepot.Longitude},
+			})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Route.Status != proto.RouteStatus_ROUTE_STATUS_PENDING {
+				t.Errorf("status = %s, want pending", resp.Route.Status)
+			}
+		})
+	}
+}
+
+// A route whose stops are not yet delivered has no delivery timestamps and must still convert
+func TestRouteService_GetRoute(t *testing.T) {
+	s := newTestServer(t)
+	route, pkg := s.createRoute(t)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{name: "route with an undelivered package", id: route.ID.Hex(), wantCode: codes.OK},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.routes.GetRoute(context.Background(), &proto.GetRouteRequest{Id: tt.id})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(resp.Route.Packages) != 1 {
+				t.Fatalf("route has %d stops, want 1", len(resp.Route.Packages))
+			}
+			if stop := resp.Route.Packages[0]; stop.PackageId != pkg.ID.Hex() || stop.Delivered || stop.DeliveryTimestamp != nil || stop.PlannedArrival == nil {
+				t.Errorf("stop = %v, want an undelivered planned stop", stop)
+			}
+		})
+	}
+}
+
+func TestRouteService_GetRouteETA(t *testing.T) {
+	s := newTestServer(t)
+	route, _ := s.createActiveRoute(t)
+
+	resp, err := s.routes.GetRouteETA(context.Background(), &proto.GetRouteETARequest{Id: route.ID.Hex()})
+	wantCode(t, err, codes.OK)
+	if stop := resp.Route.Packages[0]; stop.ProjectedArrival == nil {
+		t.Errorf("stop = %v, want a projected arrival", stop)
+	}
+}
+
+func TestRouteService_ListRoutes(t *testing.T) {
+	s := newTestServer(t)
+	pending, _ := s.createRoute(t)
+	s.createActiveRoute(t)
+
+	tests := []struct {
+		name      string
+		req       *proto.ListRoutesRequest
+		wantCode  codes.Code
+		wantCount int
+	}{
+		{name: "no filter", req: &proto.ListRoutesRequest{}, wantCode: codes.OK, wantCount: 2},
+		{name: "by status", req: &proto.ListRoutesRequest{Status: proto.RouteStatus_ROUTE_STATUS_ACTIVE}, wantCode: codes.OK, wantCount: 1},
+		{name: "by driver", req: &proto.ListRoutesRequest{DriverId: pending.DriverID.Hex()}, wantCode: codes.OK, wantCount: 1},
+		{name: "after the delivery day", req: &proto.ListRoutesRequest{DateFrom: timestamppb.New(testDate.AddDate(0, 0, 1))}, wantCode: codes.OK, wantCount: 0},
+		{name: "malformed driver id", req: &proto.ListRoutesRequest{DriverId: "nope"}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.routes.ListRoutes(context.Background(), tt.req)
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && len(resp.Routes) != tt.wantCount {
+				t.Errorf("listed %d routes, want %d", len(resp.Routes), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestRouteService_UpdateRoute(t *testing.T) {
+	nextDay := testDate.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name     string
+		driverID string
+		date     *timestamppb.Timestamp
+		wantCode codes.Code
+	}{
+		{name: "valid update", date: timestamppb.New(nextDay), wantCode: codes.OK},
+		{name: "unknown driver", driverID: primitive.NewObjectID().Hex(), date: timestamppb.New(nextDay), wantCode: codes.NotFound},
+		{name: "malformed driver id", driverID: "nope", date: timestamppb.New(nextDay), wantCode: codes.InvalidArgument},
+		{name: "missing date", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			driverID := tt.driverID
+			if driverID == "" {
+				driverID = s.createDriver(t).ID.Hex()
+			}
+
+			resp, err := s.routes.UpdateRoute(context.Background(), &proto.UpdateRouteRequest{
+				Id:                  route.ID.Hex(),
+				DriverId:            driverID,
+				Date:                tt.date,
+				EstimatedDistanceKm: 999,
+				EstimatedTimeMin:    999,
+			})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if resp.Route.DriverId != driverID || !resp.Route.Date.AsTime().Equal(nextDay) {
+				t.Errorf("route = %v, want it reassigned to %s on %v", resp.Route, driverID, nextDay)
+			}
+			// The estimates sent by the client are ignored in favour of the server's
+			if resp.Route.EstimatedDistanceKm == 0 || resp.Route.EstimatedDistanceKm == 999 || resp.Route.EstimatedTimeMin == 999 {
+				t.Errorf("route = %v, want the estimates calculated by the server", resp.Route)
+			}
+		})
+	}
+}
+
+func TestRouteService_MarkRouteAsCompleted(t *testing.T) {
+	tests := []struct {
+		name     string
+		deliver  bool
+		wantCode codes.Code
+	}{
+		{name: "every stop delivered", deliver: true, wantCode: codes.OK},
+		{name: "pending stops", wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			ctx := context.Background()
+			route, pkg := s.createActiveRoute(t)
+			if tt.deliver {
+				if _, err := s.routeService.UpdatePackageDeliveryStatus(ctx, route.ID, pkg.ID, true, nil); err != nil {
+					t.Fatalf("UpdatePackageDeliveryStatus: %v", err)
+				}
+			}
+
+			resp, err := s.routes.MarkRouteAsCompleted(ctx, &proto.MarkRouteAsCompletedRequest{Id: route.ID.Hex()})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && (!resp.Route.Completed || resp.Route.Packages[0].DeliveryTimestamp == nil) {
+				t.Errorf("route = %v, want it completed with a delivered stop", resp.Route)
+			}
+		})
+	}
+}
+
+func TestRouteService_UpdateRouteStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   proto.RouteStatus
+		wantCode codes.Code
+	}{
+		{name: "start a pending route", status: proto.RouteStatus_ROUTE_STATUS_ACTIVE, wantCode: codes.OK},
+		{name: "cancel a pending route", status: proto.RouteStatus_ROUTE_STATUS_CANCELLED, wantCode: codes.OK},
+		{name: "complete a pending route", status: proto.RouteStatus_ROUTE_STATUS_COMPLETED, wantCode: codes.FailedPrecondition},
+		{name: "unspecified status", status: proto.RouteStatus_ROUTE_STATUS_UNSPECIFIED, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+
+			resp, err := s.routes.UpdateRouteStatus(context.Background(), &proto.UpdateRouteStatusRequest{Id: route.ID.Hex(), Status: tt.status})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Route.Status != tt.status {
+				t.Errorf("status = %s, want %s", resp.Route.Status, tt.status)
+			}
+		})
+	}
+}
+
+func TestRouteService_AddPackagesToRoute(t *testing.T) {
+	tests := []struct {
+		name      string
+		packageID func(s *testServer) string
+		wantCode  codes.Code
+	}{
+		{name: "pending package", packageID: func(s *testServer) string { return s.createPackage(t).ID.Hex() }, wantCode: codes.OK},
+		{name: "unknown package", packageID: func(*testServer) string { return primitive.NewObjectID().Hex() }, wantCode: codes.NotFound},
+		{name: "malformed package id", packageID: func(*testServer) string { return "nope" }, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+
+			_, err := s.routes.AddPackagesToRoute(context.Background(), &proto.AddPackagesToRouteRequest{RouteId: route.ID.Hex(), PackageIds: []string{tt.packageID(s)}})
+			wantCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestRouteService_OptimizeRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    bool
+		wantCode codes.Code
+	}{
+		{name: "pending route", wantCode: codes.OK},
+		{name: "active route", start: true, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			if tt.start {
+				route, _ = s.createActiveRoute(t)
+			}
+
+			resp, err := s.routes.OptimizeRoute(context.Background(), &proto.OptimizeRouteRequest{Id: route.ID.Hex()})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && resp.Route.Id != route.ID.Hex() {
+				t.Errorf("route id = %s, want %s", resp.Route.Id, route.ID.Hex())
+			}
+		})
+	}
+}
+
+func TestRouteService_UpdatePackageDeliveryStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    bool
+		proof    *proto.ProofOfDelivery
+		wantCode codes.Code
+	}{
+		{name: "active route", start: true, wantCode: codes.OK},
+		{name: "active route with proof", start: true, proof: &proto.ProofOfDelivery{RecipientName: "Bob"}, wantCode: codes.OK},
+		{name: "route not started", wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			ctx := context.Background()
+			route, pkg := s.createRoute(t)
+			if tt.start {
+				route, pkg = s.createActiveRoute(t)
+			}
+
+			resp, err := s.routes.UpdatePackageDeliveryStatus(ctx, &proto.UpdatePackageDeliveryStatusRequest{RouteId: route.ID.Hex(), PackageId: pkg.ID.Hex(), Delivered: true, Proof: tt.proof})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if stop := resp.Route.Packages[0]; !stop.Delivered || stop.DeliveryTimestamp == nil {
+				t.Errorf("returned stop = %v, want it delivered with a timestamp", stop)
+			}
+
+			stored, err := s.routes.GetRoute(ctx, &proto.GetRouteRequest{Id: route.ID.Hex()})
+			wantCode(t, err, codes.OK)
+			if stop := stored.Route.Packages[0]; !stop.Delivered || stop.DeliveryTimestamp == nil {
+				t.Errorf("stored stop = %v, want it delivered with a timestamp", stop)
+			}
+		})
+	}
+}
+
+func TestRouteService_RecordFailedDeliveryAttempt(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    bool
+		reason   proto.DeliveryFailureReason
+		wantCode codes.Code
+	}{
+		{name: "active route", start: true, reason: proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_CUSTOMER_NOT_HOME, wantCode: codes.OK},
+		{name: "unspecified reason", start: true, wantCode: codes.InvalidArgument},
+		{name: "route not started", reason: proto.DeliveryFailureReason_DELIVERY_FAILURE_REASON_REFUSED, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, pkg := s.createRoute(t)
+			if tt.start {
+				route, pkg = s.createActiveRoute(t)
+			}
+
+			resp, err := s.routes.RecordFailedDeliveryAttempt(context.Background(), &proto.RecordFailedDeliveryAttemptRequest{RouteId: route.ID.Hex(), PackageId: pkg.ID.Hex(), Reason: tt.reason, Note: "no answer"})
+			wantCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if resp.Package.FailedAttempts != 1 || resp.Package.LastFailure.GetReason() != tt.reason || resp.Package.Status != proto.PackageStatus_PACKAGE_STATUS_PENDING {
+				t.Errorf("package = %v, want it re-queued after one failed attempt", resp.Package)
+			}
+		})
+	}
+}
+
+func TestRouteService_DeleteRoute(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	route, _ := s.createRoute(t)
+
+	_, err := s.routes.DeleteRoute(ctx, &proto.DeleteRouteRequest{Id: route.ID.Hex()})
+	wantCode(t, err, codes.OK)
+
+	_, err = s.routes.GetRoute(ctx, &proto.GetRouteRequest{Id: route.ID.Hex()})
+	if err == nil {
+		t.Error("GetRoute() after delete succeeded, want an error")
+	}
+}
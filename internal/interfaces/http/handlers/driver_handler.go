@@ -8,6 +8,7 @@ import (
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 )
 
 // DriverHandler handles HTTP requests for drivers
@@ -37,25 +38,27 @@ func (h *DriverHandler) RegisterRoutes(router *gin.Engine) {
 
 // CreateDriverRequest represents the request body for creating a driver
 type CreateDriverRequest struct {
-	Name        string `json:"name" binding:"required"`
-	VehicleType string `json:"vehicle_type" binding:"required,oneof=bike van truck"`
+	Name             string                  `json:"name" binding:"required"`
+	VehicleType      string                  `json:"vehicle_type" binding:"required,oneof=bike van truck"`
+	CapacityOverride *models.VehicleCapacity `json:"capacity_override"`
 }
 
 // CreateDriver handles the creation of a new driver
 func (h *DriverHandler) CreateDriver(c *gin.Context) {
 	var req CreateDriverRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	vehicleType := models.VehicleType(req.VehicleType)
-	driver, err := h.service.CreateDriver(c.Request.Context(), req.Name, vehicleType)
+	driver, err := h.service.CreateDriver(c.Request.Context(), req.Name, vehicleType, req.CapacityOverride)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, driver.Version)
 	c.JSON(http.StatusCreated, driver)
 }
 
@@ -63,71 +66,94 @@ func (h *DriverHandler) CreateDriver(c *gin.Context) {
 func (h *DriverHandler) GetDriver(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid driver id"})
+		respondBadRequest(c, "invalid driver id")
 		return
 	}
 
 	driver, err := h.service.GetDriver(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "driver not found"})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, driver.Version)
 	c.JSON(http.StatusOK, driver)
 }
 
-// ListDrivers handles retrieving all drivers
+// ListDriversQuery represents the query parameters for listing drivers
+type ListDriversQuery struct {
+	PageQuery
+	VehicleType string `form:"vehicle_type" binding:"omitempty,oneof=bike van truck"`
+	Active      *bool  `form:"active"`
+}
+
+// ListDrivers handles retrieving one page of the drivers matching the query filters
 func (h *DriverHandler) ListDrivers(c *gin.Context) {
-	drivers, err := h.service.ListDrivers(c.Request.Context())
+	var query ListDriversQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := repositories.DriverFilter{
+		VehicleType: models.VehicleType(query.VehicleType),
+		Active:      query.Active,
+	}
+	page, err := h.service.ListDrivers(c.Request.Context(), filter, query.pageRequest())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, drivers)
+	c.JSON(http.StatusOK, page)
 }
 
 // UpdateDriverRequest represents the request body for updating a driver
 type UpdateDriverRequest struct {
-	Name        string             `json:"name" binding:"required"`
-	VehicleType models.VehicleType `json:"vehicle_type" binding:"required"`
-	Active      bool               `json:"active"`
+	Name             string                  `json:"name" binding:"required"`
+	VehicleType      models.VehicleType      `json:"vehicle_type" binding:"required"`
+	Active           bool                    `json:"active"`
+	CapacityOverride *models.VehicleCapacity `json:"capacity_override"`
 }
 
-// UpdateDriver handles updating a driver
+// UpdateDriver handles updating a driver, only if it is still at the version in If-Match when given
 func (h *DriverHandler) UpdateDriver(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid driver ID"})
+		respondBadRequest(c, "invalid driver ID")
 		return
 	}
 
 	var req UpdateDriverRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	driver, err := h.service.GetDriver(c.Request.Context(), id)
+	version, err := ifMatchVersion(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
-	if driver == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "driver not found"})
+
+	driver, err := h.service.GetDriver(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
 	driver.Name = req.Name
 	driver.VehicleType = req.VehicleType
 	driver.Active = req.Active
+	driver.CapacityOverride = req.CapacityOverride
 
-	updatedDriver, err := h.service.UpdateDriver(c.Request.Context(), id, req.Name, req.VehicleType, req.Active)
+	updatedDriver, err := h.service.UpdateDriver(c.Request.Context(), id, version, req.Name, req.VehicleType, req.Active, req.CapacityOverride)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, updatedDriver.Version)
 	c.JSON(http.StatusOK, updatedDriver)
 }
 
@@ -135,12 +161,12 @@ func (h *DriverHandler) UpdateDriver(c *gin.Context) {
 func (h *DriverHandler) DeleteDriver(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid driver ID"})
+		respondBadRequest(c, "invalid driver ID")
 		return
 	}
 
 	if err := h.service.DeleteDriver(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -151,13 +177,13 @@ func (h *DriverHandler) DeleteDriver(c *gin.Context) {
 func (h *DriverHandler) GetDriverRoutes(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid driver ID"})
+		respondBadRequest(c, "invalid driver ID")
 		return
 	}
 
 	routes, err := h.service.GetDriverRoutes(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func TestDriverHandler_CreateDriver(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       any
+		wantStatus int
+	}{
+		{name: "valid driver", body: CreateDriverRequest{Name: "Ann", VehicleType: "van"}, wantStatus: http.StatusCreated},
+		{name: "unknown vehicle type", body: CreateDriverRequest{Name: "Ann", VehicleType: "boat"}, wantStatus: http.StatusBadRequest},
+		{name: "missing name", body: CreateDriverRequest{VehicleType: "bike"}, wantStatus: http.StatusBadRequest},
+		{name: "malformed body", body: "{", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			rec := s.do(t, http.MethodPost, "/api/v1/drivers", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusCreated {
+				var driver models.Driver
+				decode(t, rec, &driver)
+				if driver.ID.IsZero() || driver.VehicleType != models.VehicleTypeVan {
+					t.Errorf("driver = %+v, want a stored van driver", driver)
+				}
+			}
+		})
+	}
+}
+
+func TestDriverHandler_GetDriver(t *testing.T) {
+	s := newTestServer(t)
+	driver := s.createDriver(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing driver", id: driver.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown driver", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/drivers/"+tt.id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestDriverHandler_ListDrivers(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCount  int
+		wantMore   bool
+	}{
+		{name: "no filter", wantStatus: http.StatusOK, wantCount: 3},
+		{name: "by vehicle type", query: "?vehicle_type=bike", wantStatus: http.StatusOK, wantCount: 1},
+		{name: "inactive drivers", query: "?active=false", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "first page", query: "?limit=2", wantStatus: http.StatusOK, wantCount: 2, wantMore: true},
+		{name: "unknown vehicle type", query: "?vehicle_type=boat", wantStatus: http.StatusBadRequest},
+		{name: "unknown order", query: "?order=random", wantStatus: http.StatusBadRequest},
+		{name: "malformed cursor", query: "?cursor=nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createDriver(t)
+			s.createDriver(t)
+			if _, err := s.driverService.CreateDriver(context.Background(), "Bo", models.VehicleTypeBike, nil); err != nil {
+				t.Fatalf("CreateDriver: %v", err)
+			}
+
+			rec := s.do(t, http.MethodGet, "/api/v1/drivers"+tt.query, nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var page repositories.Page[models.Driver]
+			decode(t, rec, &page)
+			if len(page.Items) != tt.wantCount || (page.NextCursor != "") != tt.wantMore {
+				t.Errorf("listed %d drivers with next cursor %q, want %d and more %v", len(page.Items), page.NextCursor, tt.wantCount, tt.wantMore)
+			}
+		})
+	}
+}
+
+func TestDriverHandler_ListDrivers_NextPage(t *testing.T) {
+	s := newTestServer(t)
+	s.createDriver(t)
+	last := s.createDriver(t)
+
+	rec := s.do(t, http.MethodGet, "/api/v1/drivers?limit=1", nil)
+	var first repositories.Page[models.Driver]
+	decode(t, rec, &first)
+
+	rec = s.do(t, http.MethodGet, "/api/v1/drivers?limit=1&cursor="+first.NextCursor, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var second repositories.Page[models.Driver]
+	decode(t, rec, &second)
+	if len(second.Items) != 1 || second.Items[0].ID != last.ID || second.NextCursor != "" {
+		t.Errorf("second page = %+v, want only the last driver", second)
+	}
+}
+
+func TestDriverHandler_UpdateDriver(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       any
+		wantStatus int
+	}{
+		{name: "valid update", body: UpdateDriverRequest{Name: "Renamed", VehicleType: models.VehicleTypeTruck}, wantStatus: http.StatusOK},
+		{name: "unknown driver", id: primitive.NewObjectID().Hex(), body: UpdateDriverRequest{Name: "Renamed", VehicleType: models.VehicleTypeTruck}, wantStatus: http.StatusNotFound},
+		{name: "missing vehicle type", body: UpdateDriverRequest{Name: "Renamed"}, wantStatus: http.StatusBadRequest},
+		{name: "malformed id", id: "nope", body: UpdateDriverRequest{Name: "Renamed", VehicleType: models.VehicleTypeTruck}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createDriver(t).ID.Hex()
+			}
+
+			rec := s.do(t, http.MethodPut, "/api/v1/drivers/"+id, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var driver models.Driver
+				decode(t, rec, &driver)
+				if driver.Name != "Renamed" || driver.VehicleType != models.VehicleTypeTruck || driver.Active {
+					t.Errorf("driver = %+v, want the updated fields", driver)
+				}
+			}
+		})
+	}
+}
+
+func TestDriverHandler_DeleteDriver(t *testing.T) {
+	tests := []struct {
+		name        string
+		activeRoute bool
+		id          string
+		wantStatus  int
+	}{
+		{name: "idle driver", wantStatus: http.StatusNoContent},
+		{name: "driver on an active route", activeRoute: true, wantStatus: http.StatusConflict},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				driver := s.createDriver(t)
+				id = driver.ID.Hex()
+				if tt.activeRoute {
+					route, err := s.routeService.CreateRoute(context.Background(), driver.ID, testDate, testDepot)
+					if err != nil {
+						t.Fatalf("CreateRoute: %v", err)
+					}
+					if err := s.routeService.UpdateRouteStatus(context.Background(), route.ID, models.RouteStatusActive); err != nil {
+						t.Fatalf("UpdateRouteStatus: %v", err)
+					}
+				}
+			}
+
+			rec := s.do(t, http.MethodDelete, "/api/v1/drivers/"+id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestDriverHandler_GetDriverRoutes(t *testing.T) {
+	s := newTestServer(t)
+	route, _ := s.createRoute(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantRoutes int
+	}{
+		{name: "driver with a route", id: route.DriverID.Hex(), wantStatus: http.StatusOK, wantRoutes: 1},
+		{name: "driver without routes", id: s.createDriver(t).ID.Hex(), wantStatus: http.StatusOK},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/drivers/"+tt.id+"/routes", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var routes []models.Route
+			decode(t, rec, &routes)
+			if len(routes) != tt.wantRoutes {
+				t.Errorf("listed %d routes, want %d", len(routes), tt.wantRoutes)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/apierror"
+)
+
+// respondError writes err as a structured error body with the HTTP status of its domain kind
+func respondError(c *gin.Context, err error) {
+	apiErr := apierror.FromError(c.Request.Context(), err)
+	c.JSON(apiErr.HTTPStatus(), gin.H{"error": apiErr})
+}
+
+// respondBadRequest writes a validation error for a malformed request
+func respondBadRequest(c *gin.Context, message string) {
+	respondError(c, apierror.Validation(message))
+}
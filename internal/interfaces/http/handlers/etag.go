@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setETag exposes the version of the returned entity as its entity tag
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion parses the If-Match header into the version an update is based on; it is 0,
+// meaning any version, when the header is absent or "*"
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, fmt.Errorf("invalid If-Match header %q", header)
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header %q", header)
+	}
+	return version, nil
+}
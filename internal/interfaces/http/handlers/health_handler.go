@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/health"
+)
+
+// HealthHandler serves the liveness and readiness probes
+type HealthHandler struct {
+	checker *health.Checker
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(checker *health.Checker) *HealthHandler {
+	return &HealthHandler{
+		checker: checker,
+	}
+}
+
+// RegisterRoutes registers the probe routes; /health is kept as an alias of /livez for existing probes
+func (h *HealthHandler) RegisterRoutes(router *gin.Engine) {
+	router.GET("/livez", h.Live)
+	router.GET("/readyz", h.Ready)
+	router.GET("/health", h.Live)
+}
+
+// Live reports that the process is up and serving, whatever the state of its dependencies
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": health.StatusOK})
+}
+
+// Ready reports whether the server can take traffic, with the result of each dependency check;
+// it answers 503 Service Unavailable when a check fails or the server is draining
+func (h *HealthHandler) Ready(c *gin.Context) {
+	report := h.checker.Ready(c.Request.Context())
+	if !report.Ready() {
+		c.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/health"
+)
+
+func TestHealthHandler_Live(t *testing.T) {
+	s := newTestServer(t)
+	s.checker.AddCheck("mongodb", func(context.Context) error { return errors.New("server selection timeout") })
+
+	for _, path := range []string{"/livez", "/health"} {
+		if rec := s.do(t, http.MethodGet, path, nil); rec.Code != http.StatusOK {
+			t.Errorf("GET %s status = %d, want %d whatever the dependencies: %s", path, rec.Code, http.StatusOK, rec.Body)
+		}
+	}
+}
+
+func TestHealthHandler_Ready(t *testing.T) {
+	passing := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("pending migrations: 3") }
+
+	tests := []struct {
+		name       string
+		checks     map[string]health.Check
+		drain      bool
+		wantStatus int
+		wantReport health.Report
+	}{
+		{
+			name:       "every check passes",
+			checks:     map[string]health.Check{"mongodb": passing, "migrations": passing},
+			wantStatus: http.StatusOK,
+			wantReport: health.Report{Status: health.StatusOK, Checks: map[string]string{"mongodb": "ok", "migrations": "ok"}},
+		},
+		{
+			name:       "a check fails",
+			checks:     map[string]health.Check{"mongodb": passing, "migrations": failing},
+			wantStatus: http.StatusServiceUnavailable,
+			wantReport: health.Report{Status: health.StatusUnavailable, Checks: map[string]string{"mongodb": "ok", "migrations": "pending migrations: 3"}},
+		},
+		{
+			name:       "draining",
+			checks:     map[string]health.Check{"mongodb": passing},
+			drain:      true,
+			wantStatus: http.StatusServiceUnavailable,
+			wantReport: health.Report{Status: health.StatusDraining},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			for name, check := range tt.checks {
+				s.checker.AddCheck(name, check)
+			}
+			if tt.drain {
+				s.checker.Drain()
+			}
+
+			rec := s.do(t, http.MethodGet, "/readyz", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			var report health.Report
+			decode(t, rec, &report)
+			if report.Status != tt.wantReport.Status || len(report.Checks) != len(tt.wantReport.Checks) {
+				t.Fatalf("report = %+v, want %+v", report, tt.wantReport)
+			}
+			for name, want := range tt.wantReport.Checks {
+				if report.Checks[name] != want {
+					t.Errorf("check %s = %q, want %q", name, report.Checks[name], want)
+				}
+			}
+		})
+	}
+}
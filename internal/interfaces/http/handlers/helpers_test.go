@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	grpchealth "google.golang.org/grpc/health"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/optimization"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/health"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/persistence/memory"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/storage/filesystem"
+)
+
+// spans records every span ended by the tests; the global provider can only be installed once
+var spans = tracetest.NewSpanRecorder()
+
+func init() {
+	gin.SetMode(gin.TestMode)
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// testServer serves every handler over in-memory repositories, as cmd/main.go wires them
+type testServer struct {
+	router *gin.Engine
+
+	events  *memory.PackageEventRepository
+	checker *health.Checker
+
+	driverService   *services.DriverService
+	packageService  *services.PackageService
+	routeService    *services.RouteService
+	planningService *services.PlanningService
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	blobs, err := filesystem.NewBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewBlobStore: %v", err)
+	}
+
+	drivers := memory.NewDriverRepository()
+	packages := memory.NewPackageRepository()
+	routes := memory.NewRouteRepository()
+	events := memory.NewPackageEventRepository()
+	plans := memory.NewPlanRepository()
+	optimizer := optimization.NewDefaultOptimizer()
+
+	s := &testServer{events: events, checker: health.NewChecker(grpchealth.NewServer(), time.Second)}
+	s.driverService = services.NewDriverService(drivers, routes)
+	s.packageService = services.NewPackageService(packages, events, blobs)
+	s.routeService = services.NewRouteService(routes, drivers, packages, events, memory.NewUnitOfWork(), blobs, optimizer, models.DefaultVehicleCapacities, 3, 8*time.Hour)
+	s.planningService = services.NewPlanningService(plans, drivers, packages, s.routeService, optimization.NewFleetPlanner(optimizer), models.DefaultVehicleCapacities)
+
+	s.router = gin.New()
+	s.router.Use(TracingMiddleware("test"), RequestIDMiddleware(), LoggerMiddleware(), MetricsMiddleware(), RecoveryMiddleware(), ActorMiddleware())
+	NewDriverHandler(s.driverService).RegisterRoutes(s.router)
+	NewPackageHandler(s.packageService, s.routeService).RegisterRoutes(s.router)
+	NewRouteHandler(s.routeService).RegisterRoutes(s.router)
+	NewPlanHandler(s.planningService).RegisterRoutes(s.router)
+	NewHealthHandler(s.checker).RegisterRoutes(s.router)
+	return s
+}
+
+var testDepot = &models.Location{Latitude: 40.4168, Longitude: -3.7038}
+
+// testDate is the delivery day used by every fixture
+var testDate = time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)
+
+// do serves a request, encoding body as JSON unless it is already a string or reader;
+// headers are name/value pairs applied after the default JSON content type
+func (s *testServer) do(t *testing.T, method, path string, body any, headers ...string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	case string:
+		reader = bytes.NewBufferString(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("marshal request: %v", err)
+		}
+		reader = bytes.NewBuffer(data)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// etag formats a version as the entity tag the handlers return for it
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// decode unmarshals a response body into out
+func decode(t *testing.T, rec *httptest.ResponseRecorder, out any) {
+	t.Helper()
+
+	if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+}
+
+func (s *testServer) createDriver(t *testing.T) *models.Driver {
+	t.Helper()
+
+	driver, err := s.driverService.CreateDriver(context.Background(), "Ann", models.VehicleTypeVan, nil)
+	if err != nil {
+		t.Fatalf("CreateDriver: %v", err)
+	}
+	return driver
+}
+
+func (s *testServer) createPackage(t *testing.T) *models.Package {
+	t.Helper()
+
+	location := &models.Location{Latitude: testDepot.Latitude + 0.01, Longitude: testDepot.Longitude}
+	pkg, err := s.packageService.CreatePackage(context.Background(), primitive.NewObjectID().Hex(), "Customer", "Street 1", "600000000", 1, 0.01, location, nil, 5)
+	if err != nil {
+		t.Fatalf("CreatePackage: %v", err)
+	}
+	return pkg
+}
+
+// createRoute creates a pending route for a new driver loaded with one package
+func (s *testServer) createRoute(t *testing.T) (*models.Route, *models.Package) {
+	t.Helper()
+	ctx := context.Background()
+
+	route, err := s.routeService.CreateRoute(ctx, s.createDriver(t).ID, testDate, testDepot)
+	if err != nil {
+		t.Fatalf("CreateRoute: %v", err)
+	}
+	pkg := s.createPackage(t)
+	if err := s.routeService.AddPackagesToRoute(ctx, route.ID, []primitive.ObjectID{pkg.ID}); err != nil {
+		t.Fatalf("AddPackagesToRoute: %v", err)
+	}
+	return route, pkg
+}
+
+// createActiveRoute creates a route with one package and starts it
+func (s *testServer) createActiveRoute(t *testing.T) (*models.Route, *models.Package) {
+	t.Helper()
+
+	route, pkg := s.createRoute(t)
+	if err := s.routeService.UpdateRouteStatus(context.Background(), route.ID, models.RouteStatusActive); err != nil {
+		t.Fatalf("UpdateRouteStatus: %v", err)
+	}
+	return route, pkg
+}
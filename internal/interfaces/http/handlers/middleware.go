@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/apierror"
+)
+
+// ActorHeader identifies the caller responsible for a change
+const ActorHeader = "X-Actor"
+
+// RequestIDHeader correlates a request with the logs it produces, here and in the caller
+const RequestIDHeader = "X-Request-ID"
+
+// ActorMiddleware attributes the changes made by a request to the actor named in its X-Actor header
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if actor := c.GetHeader(ActorHeader); actor != "" {
+			c.Request = c.Request.WithContext(services.WithActor(c.Request.Context(), actor))
+		}
+		c.Next()
+	}
+}
+
+// RequestIDMiddleware propagates the caller's X-Request-ID, or generates one, into the request
+// context and echoes it in the response
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := logging.RequestID(c.GetHeader(RequestIDHeader))
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// LoggerMiddleware logs each request once it has been served
+func LoggerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		level := slog.LevelInfo
+		if c.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+		slog.Log(c.Request.Context(), level, "http request",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", c.Writer.Status()),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+		)
+	}
+}
+
+// TracingMiddleware serves each request in a span continuing the caller's trace context, or
+// starting a trace; metric scrapes and health checks are not traced
+func TracingMiddleware(service string) gin.HandlerFunc {
+	return otelgin.Middleware(service, otelgin.WithFilter(func(r *http.Request) bool {
+		return !untracedPaths[r.URL.Path]
+	}))
+}
+
+// untracedPaths are the metric scrape and probe routes, polled too often to be worth a trace
+var untracedPaths = map[string]bool{"/metrics": true, "/health": true, "/livez": true, "/readyz": true}
+
+// MetricsMiddleware records the count and latency of each request by route template and status;
+// requests matching no route are grouped under "unmatched"
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// RecoveryMiddleware turns a panic in a handler into a logged internal error response
+func RecoveryMiddleware() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
+		slog.ErrorContext(c.Request.Context(), "handler panicked",
+			slog.Any("panic", recovered),
+			slog.String("stack", string(debug.Stack())),
+		)
+		respondError(c, apierror.Internal())
+		c.Abort()
+	})
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/logging"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/infrastructure/metrics"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/interfaces/apierror"
+)
+
+func TestActorMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		headers   []string
+		wantActor string
+	}{
+		{name: "actor header", headers: []string{ActorHeader, "dispatcher-7"}, wantActor: "dispatcher-7"},
+		{name: "no actor header", wantActor: services.SystemActor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			rec := s.do(t, http.MethodPost, "/api/v1/packages", validCreatePackageRequest(), tt.headers...)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+			var pkg models.Package
+			decode(t, rec, &pkg)
+
+			rec = s.do(t, http.MethodGet, "/api/v1/packages/"+pkg.ID.Hex()+"/events", nil)
+			var events []models.PackageEvent
+			decode(t, rec, &events)
+			if len(events) != 1 || events[0].Actor != tt.wantActor {
+				t.Errorf("events = %+v, want one created by %q", events, tt.wantActor)
+			}
+		})
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		wantID  string
+	}{
+		{name: "propagates the caller's ID", headers: []string{RequestIDHeader, "req-42"}, wantID: "req-42"},
+		{name: "generates a missing ID"},
+		{name: "replaces an unsafe ID", headers: []string{RequestIDHeader, "req 42\nforged"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			var logs bytes.Buffer
+			logger, err := logging.New(&logs, "info")
+			if err != nil {
+				t.Fatalf("logging.New: %v", err)
+			}
+			defer slog.SetDefault(slog.Default())
+			slog.SetDefault(logger)
+
+			pkg := s.createPackage(t)
+			rec := s.do(t, http.MethodPatch, "/api/v1/packages/"+pkg.ID.Hex()+"/status", map[string]string{"status": "cancelled"}, tt.headers...)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			id := rec.Header().Get(RequestIDHeader)
+			if tt.wantID != "" && id != tt.wantID || tt.wantID == "" && len(id) != 32 {
+				t.Fatalf("%s = %q, want %q or a generated ID", RequestIDHeader, id, tt.wantID)
+			}
+
+			// Both the access log and the service log carry the request ID
+			messages := map[string]bool{}
+			for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+				var record map[string]any
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("log line %q is not JSON: %v", line, err)
+				}
+				if record[logging.RequestIDKey] == id {
+					messages[record["msg"].(string)] = true
+				}
+			}
+			if !messages["http request"] || !messages["package status changed"] {
+				t.Errorf("records with request ID %q = %v, want the access and the service logs", id, messages)
+			}
+		})
+	}
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	s := newTestServer(t)
+	var logs bytes.Buffer
+	logger, err := logging.New(&logs, "info")
+	if err != nil {
+		t.Fatalf("logging.New: %v", err)
+	}
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logger)
+	s.router.GET("/panic", func(*gin.Context) { panic("mongodb://admin:secret@db") })
+
+	rec := s.do(t, http.MethodGet, "/panic", nil, RequestIDHeader, "req-42")
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusInternalServerError, rec.Body)
+	}
+	var body struct{ Error apierror.Error }
+	decode(t, rec, &body)
+	if body.Error.Code != apierror.CodeInternal || strings.Contains(rec.Body.String(), "secret") {
+		t.Errorf("body = %s, want a generic internal error", rec.Body)
+	}
+	// The panic is only logged, once, with the request ID
+	if n := strings.Count(logs.String(), "secret"); n != 1 || !strings.Contains(logs.String(), `"request_id":"req-42"`) {
+		t.Errorf("logs = %s, want the panic logged once with the request ID", logs.String())
+	}
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tests := []struct {
+		name          string
+		headers       []string
+		wantContinued bool
+	}{
+		{name: "continues the caller's trace", headers: []string{"traceparent", "00-" + traceID + "-00f067aa0ba902b7-01"}, wantContinued: true},
+		{name: "starts a trace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			before := len(spans.Ended())
+
+			rec := s.do(t, http.MethodPost, "/api/v1/packages", validCreatePackageRequest(), tt.headers...)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+
+			// The request span is the parent of the service span
+			ended := spans.Ended()[before:]
+			var request, service sdktrace.ReadOnlySpan
+			for _, span := range ended {
+				switch span.Name() {
+				case "/api/v1/packages":
+					request = span
+				case "PackageService.CreatePackage":
+					service = span
+				}
+			}
+			if request == nil || service == nil || service.Parent().SpanID() != request.SpanContext().SpanID() {
+				t.Fatalf("spans = %v, want a request span parent of PackageService.CreatePackage", ended)
+			}
+			if continued := request.Parent().IsValid(); continued != tt.wantContinued {
+				t.Errorf("request span continues a trace = %v, want %v", continued, tt.wantContinued)
+			}
+			if tt.wantContinued && service.SpanContext().TraceID().String() != traceID {
+				t.Errorf("trace ID = %s, want the caller's %s", service.SpanContext().TraceID(), traceID)
+			}
+		})
+	}
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   func(pkg *models.Package) string
+		series string
+	}{
+		{
+			name:   "counts by route template",
+			path:   func(pkg *models.Package) string { return "/api/v1/packages/" + pkg.ID.Hex() },
+			series: `http_requests_total{method="GET",route="/api/v1/packages/:id",status="200"}`,
+		},
+		{
+			name:   "counts error statuses",
+			path:   func(*models.Package) string { return "/api/v1/packages/not-an-id" },
+			series: `http_requests_total{method="GET",route="/api/v1/packages/:id",status="400"}`,
+		},
+		{
+			name:   "groups unknown paths",
+			path:   func(*models.Package) string { return "/api/v1/nowhere" },
+			series: `http_requests_total{method="GET",route="unmatched",status="404"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			pkg := s.createPackage(t)
+			before := scrape(t, tt.series)
+
+			s.do(t, http.MethodGet, tt.path(pkg), nil)
+
+			if got := scrape(t, tt.series); got != before+1 {
+				t.Errorf("%s = %v, want %v", tt.series, got, before+1)
+			}
+		})
+	}
+}
+
+// scrape reads the current value of a series from the /metrics exposition, 0 when absent
+func scrape(t *testing.T, series string) float64 {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, series+" "); ok {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatalf("parse %q: %v", line, err)
+			}
+			return v
+		}
+	}
+	return 0
+}
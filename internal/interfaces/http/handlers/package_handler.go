@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 )
 
 // PackageHandler handles HTTP requests for packages
@@ -32,36 +35,43 @@ func (h *PackageHandler) RegisterRoutes(router *gin.Engine) {
 		packages.GET("/:id", h.GetPackage)
 		packages.PUT("/:id", h.UpdatePackage)
 		packages.DELETE("/:id", h.DeletePackage)
+		packages.PATCH("/:id/status", h.UpdatePackageStatus)
 		packages.POST("/:id/assign", h.AssignToRoute)
 		packages.POST("/:id/deliver", h.MarkAsDelivered)
 		packages.GET("/route/:route_id", h.GetPackagesByRoute)
+		packages.GET("/:id/events", h.GetPackageEvents)
+		packages.GET("/:id/pod", h.GetProofOfDelivery)
 	}
 }
 
 // CreatePackageRequest represents the request body for creating a package
 type CreatePackageRequest struct {
-	TrackingNumber  string  `json:"tracking_number" binding:"required"`
-	CustomerName    string  `json:"customer_name" binding:"required"`
-	CustomerAddress string  `json:"customer_address" binding:"required"`
-	CustomerPhone   string  `json:"customer_phone" binding:"required"`
-	WeightKg        float64 `json:"weight_kg" binding:"required,gt=0"`
-	VolumeM3        float64 `json:"volume_m3" binding:"required,gt=0"`
+	TrackingNumber     string             `json:"tracking_number" binding:"required"`
+	CustomerName       string             `json:"customer_name" binding:"required"`
+	CustomerAddress    string             `json:"customer_address" binding:"required"`
+	CustomerPhone      string             `json:"customer_phone" binding:"required"`
+	WeightKg           float64            `json:"weight_kg" binding:"required,gt=0"`
+	VolumeM3           float64            `json:"volume_m3" binding:"required,gt=0"`
+	Location           *models.Location   `json:"location"`
+	DeliveryWindow     *models.TimeWindow `json:"delivery_window"`
+	ServiceDurationMin int                `json:"service_duration_min" binding:"gte=0"`
 }
 
 // CreatePackage handles the creation of a new package
 func (h *PackageHandler) CreatePackage(c *gin.Context) {
 	var req CreatePackageRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	pkg, err := h.packageService.CreatePackage(c.Request.Context(), req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, req.WeightKg, req.VolumeM3)
+	pkg, err := h.packageService.CreatePackage(c.Request.Context(), req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, req.WeightKg, req.VolumeM3, req.Location, req.DeliveryWindow, req.ServiceDurationMin)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, pkg.Version)
 	c.JSON(http.StatusCreated, pkg)
 }
 
@@ -69,60 +79,92 @@ func (h *PackageHandler) CreatePackage(c *gin.Context) {
 func (h *PackageHandler) GetPackage(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package id"})
+		respondBadRequest(c, "invalid package id")
 		return
 	}
 
 	pkg, err := h.packageService.GetPackage(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "package not found"})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, pkg.Version)
 	c.JSON(http.StatusOK, pkg)
 }
 
-// ListPackages handles retrieving all packages
+// ListPackagesQuery represents the query parameters for listing packages
+type ListPackagesQuery struct {
+	PageQuery
+	Status         models.PackageStatus `form:"status"`
+	TrackingPrefix string               `form:"tracking_prefix"`
+	CreatedFrom    time.Time            `form:"created_from"`
+	CreatedTo      time.Time            `form:"created_to"`
+}
+
+// ListPackages handles retrieving one page of the packages matching the query filters
 func (h *PackageHandler) ListPackages(c *gin.Context) {
-	packages, err := h.packageService.ListPackages(c.Request.Context())
+	var query ListPackagesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := repositories.PackageFilter{
+		Status:         query.Status,
+		TrackingPrefix: query.TrackingPrefix,
+		CreatedFrom:    query.CreatedFrom,
+		CreatedTo:      query.CreatedTo,
+	}
+	page, err := h.packageService.ListPackages(c.Request.Context(), filter, query.pageRequest())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, packages)
+	c.JSON(http.StatusOK, page)
 }
 
 // UpdatePackageRequest represents the request body for updating a package
 type UpdatePackageRequest struct {
-	TrackingNumber  string  `json:"tracking_number" binding:"required"`
-	CustomerName    string  `json:"customer_name" binding:"required"`
-	CustomerAddress string  `json:"customer_address" binding:"required"`
-	CustomerPhone   string  `json:"customer_phone" binding:"required"`
-	WeightKg        float64 `json:"weight_kg" binding:"required,gt=0"`
-	VolumeM3        float64 `json:"volume_m3" binding:"required,gt=0"`
+	TrackingNumber     string             `json:"tracking_number" binding:"required"`
+	CustomerName       string             `json:"customer_name" binding:"required"`
+	CustomerAddress    string             `json:"customer_address" binding:"required"`
+	CustomerPhone      string             `json:"customer_phone" binding:"required"`
+	WeightKg           float64            `json:"weight_kg" binding:"required,gt=0"`
+	VolumeM3           float64            `json:"volume_m3" binding:"required,gt=0"`
+	Location           *models.Location   `json:"location"`
+	DeliveryWindow     *models.TimeWindow `json:"delivery_window"`
+	ServiceDurationMin int                `json:"service_duration_min" binding:"gte=0"`
 }
 
-// UpdatePackage handles updating a package
+// UpdatePackage handles updating a package, only if it is still at the version in If-Match when given
 func (h *PackageHandler) UpdatePackage(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package id"})
+		respondBadRequest(c, "invalid package id")
 		return
 	}
 
 	var req UpdatePackageRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	pkg, err := h.packageService.UpdatePackage(c.Request.Context(), id, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, req.WeightKg, req.VolumeM3)
+	pkg, err := h.packageService.UpdatePackage(c.Request.Context(), id, version, req.TrackingNumber, req.CustomerName, req.CustomerAddress, req.CustomerPhone, req.WeightKg, req.VolumeM3, req.Location, req.DeliveryWindow, req.ServiceDurationMin)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, pkg.Version)
 	c.JSON(http.StatusOK, pkg)
 }
 
@@ -130,18 +172,48 @@ func (h *PackageHandler) UpdatePackage(c *gin.Context) {
 func (h *PackageHandler) DeletePackage(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package id"})
+		respondBadRequest(c, "invalid package id")
 		return
 	}
 
 	if err := h.packageService.DeletePackage(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// UpdatePackageStatusRequest represents the request body for moving a package to a new status
+type UpdatePackageStatusRequest struct {
+	Status   models.PackageStatus `json:"status" binding:"required,oneof=pending assigned out_for_delivery delivered failed returned cancelled"`
+	Location *models.Location     `json:"location"`
+	Note     string               `json:"note"`
+}
+
+// UpdatePackageStatus handles cancelling or returning a package off any route; route stops change through the route endpoints
+func (h *PackageHandler) UpdatePackageStatus(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid package id")
+		return
+	}
+
+	var req UpdatePackageStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pkg, err := h.packageService.UpdatePackageStatus(c.Request.Context(), id, req.Status, req.Location, req.Note)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pkg)
+}
+
 // AssignToRouteRequest represents the request body for assigning a package to a route
 type AssignToRouteRequest struct {
 	RouteID string `json:"route_id" binding:"required"`
@@ -151,42 +223,49 @@ type AssignToRouteRequest struct {
 func (h *PackageHandler) AssignToRoute(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package id"})
+		respondBadRequest(c, "invalid package id")
 		return
 	}
 
 	var req AssignToRouteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	routeID, err := primitive.ObjectIDFromHex(req.RouteID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route id"})
+		respondBadRequest(c, "invalid route id")
 		return
 	}
 
 	err = h.routeService.AddPackagesToRoute(c.Request.Context(), routeID, []primitive.ObjectID{id})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	c.Status(http.StatusOK)
 }
 
-// MarkAsDelivered handles marking a package as delivered
+// MarkAsDelivered handles marking a package as delivered at its route stop, with an optional proof
+// of delivery
 func (h *PackageHandler) MarkAsDelivered(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package id"})
+		respondBadRequest(c, "invalid package id")
 		return
 	}
 
-	pkg, err := h.packageService.MarkAsDelivered(c.Request.Context(), id)
+	proof, err := bindDeliveryProof(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pkg, err := h.routeService.DeliverPackage(c.Request.Context(), id, proof)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
@@ -197,15 +276,49 @@ func (h *PackageHandler) MarkAsDelivered(c *gin.Context) {
 func (h *PackageHandler) GetPackagesByRoute(c *gin.Context) {
 	routeID, err := primitive.ObjectIDFromHex(c.Param("route_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route id"})
+		respondBadRequest(c, "invalid route id")
 		return
 	}
 
 	route, err := h.routeService.GetRoute(c.Request.Context(), routeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, route.Packages)
 }
+
+// GetPackageEvents handles retrieving the tracking history of a package
+func (h *PackageHandler) GetPackageEvents(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid package id")
+		return
+	}
+
+	events, err := h.packageService.GetPackageHistory(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// GetProofOfDelivery handles retrieving the proof captured when a package was delivered
+func (h *PackageHandler) GetProofOfDelivery(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid package id")
+		return
+	}
+
+	proof, err := h.packageService.GetProofOfDelivery(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, proof)
+}
@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func validCreatePackageRequest() CreatePackageRequest {
+	return CreatePackageRequest{
+		TrackingNumber:  "TRK-1",
+		CustomerName:    "Customer",
+		CustomerAddress: "Street 1",
+		CustomerPhone:   "600000000",
+		WeightKg:        2,
+		VolumeM3:        0.02,
+		Location:        &models.Location{Latitude: 40.42, Longitude: -3.70},
+	}
+}
+
+func TestPackageHandler_CreatePackage(t *testing.T) {
+	invalidLocation := validCreatePackageRequest()
+	invalidLocation.Location = &models.Location{Latitude: 120}
+	zeroWeight := validCreatePackageRequest()
+	zeroWeight.WeightKg = 0
+
+	tests := []struct {
+		name       string
+		body       any
+		wantStatus int
+	}{
+		{name: "valid package", body: validCreatePackageRequest(), wantStatus: http.StatusCreated},
+		{name: "zero weight", body: zeroWeight, wantStatus: http.StatusBadRequest},
+		{name: "invalid location", body: invalidLocation, wantStatus: http.StatusBadRequest},
+		{name: "malformed body", body: "{", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			rec := s.do(t, http.MethodPost, "/api/v1/packages", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusCreated {
+				var pkg models.Package
+				decode(t, rec, &pkg)
+				if pkg.ID.IsZero() || pkg.Status != models.PackageStatusPending {
+					t.Errorf("package = %+v, want a stored pending package", pkg)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_GetPackage(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing package", id: pkg.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown package", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/packages/"+tt.id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestPackageHandler_ListPackages(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCount  int
+	}{
+		{name: "no filter", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "by status", query: "?status=pending", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "by tracking prefix", query: "?tracking_prefix=none-", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "created later", query: "?created_from=2999-01-01T00:00:00Z", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "unknown status", query: "?status=lost", wantStatus: http.StatusBadRequest},
+		{name: "malformed date", query: "?created_from=yesterday", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createPackage(t)
+			s.createPackage(t)
+
+			rec := s.do(t, http.MethodGet, "/api/v1/packages"+tt.query, nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var page repositories.Page[models.Package]
+			decode(t, rec, &page)
+			if len(page.Items) != tt.wantCount {
+				t.Errorf("listed %d packages, want %d", len(page.Items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPackageHandler_UpdatePackage(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       any
+		wantStatus int
+	}{
+		{name: "valid update", body: UpdatePackageRequest(validCreatePackageRequest()), wantStatus: http.StatusOK},
+		{name: "unknown package", id: primitive.NewObjectID().Hex(), body: UpdatePackageRequest(validCreatePackageRequest()), wantStatus: http.StatusNotFound},
+		{name: "missing fields", body: UpdatePackageRequest{TrackingNumber: "TRK-1"}, wantStatus: http.StatusBadRequest},
+		{name: "malformed id", id: "nope", body: UpdatePackageRequest(validCreatePackageRequest()), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createPackage(t).ID.Hex()
+			}
+
+			rec := s.do(t, http.MethodPut, "/api/v1/packages/"+id, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var pkg models.Package
+				decode(t, rec, &pkg)
+				if pkg.TrackingNumber != "TRK-1" || pkg.WeightKg != 2 {
+					t.Errorf("package = %+v, want the updated fields", pkg)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_DeletePackage(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing package", wantStatus: http.StatusNoContent},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				id = s.createPackage(t).ID.Hex()
+			}
+
+			rec := s.do(t, http.MethodDelete, "/api/v1/packages/"+id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestPackageHandler_UpdatePackageStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       any
+		wantStatus int
+	}{
+		{name: "allowed transition", body: UpdatePackageStatusRequest{Status: models.PackageStatusCancelled, Note: "customer cancelled"}, wantStatus: http.StatusOK},
+		{name: "forbidden transition", body: UpdatePackageStatusRequest{Status: models.PackageStatusDelivered}, wantStatus: http.StatusConflict},
+		{name: "unknown status", body: UpdatePackageStatusRequest{Status: "lost"}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			pkg := s.createPackage(t)
+
+			rec := s.do(t, http.MethodPatch, "/api/v1/packages/"+pkg.ID.Hex()+"/status", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var updated models.Package
+				decode(t, rec, &updated)
+				if updated.Status != models.PackageStatusCancelled {
+					t.Errorf("status = %s, want cancelled", updated.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_AssignToRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		routeID    func(s *testServer) string
+		wantStatus int
+	}{
+		{name: "pending route", routeID: func(s *testServer) string { r, _ := s.createRoute(t); return r.ID.Hex() }, wantStatus: http.StatusOK},
+		{name: "unknown route", routeID: func(*testServer) string { return primitive.NewObjectID().Hex() }, wantStatus: http.StatusNotFound},
+		{name: "malformed route id", routeID: func(*testServer) string { return "nope" }, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			pkg := s.createPackage(t)
+
+			rec := s.do(t, http.MethodPost, "/api/v1/packages/"+pkg.ID.Hex()+"/assign", AssignToRouteRequest{RouteID: tt.routeID(s)})
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestPackageHandler_MarkAsDelivered(t *testing.T) {
+	multipartProof := func() (*bytes.Buffer, string) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		_ = writer.WriteField("recipient_name", "Bob")
+		_ = writer.WriteField("latitude", "40.42")
+		_ = writer.WriteField("longitude", "-3.70")
+		part, _ := writer.CreateFormFile("signature", "signature.png")
+		_, _ = part.Write([]byte("signature"))
+		_ = writer.Close()
+		return &body, writer.FormDataContentType()
+	}
+
+	tests := []struct {
+		name       string
+		offRoute   bool
+		body       func() (any, []string)
+		wantStatus int
+		wantProof  bool
+	}{
+		{name: "without proof", body: func() (any, []string) { return nil, nil }, wantStatus: http.StatusOK},
+		{name: "with JSON proof", body: func() (any, []string) { return services.DeliveryProof{RecipientName: "Bob"}, nil }, wantStatus: http.StatusOK, wantProof: true},
+		{name: "with multipart proof", body: func() (any, []string) {
+			body, contentType := multipartProof()
+			return body, []string{"Content-Type", contentType}
+		}, wantStatus: http.StatusOK, wantProof: true},
+		{name: "proof without recipient", body: func() (any, []string) { return services.DeliveryProof{}, nil }, wantStatus: http.StatusBadRequest},
+		{name: "unsupported content type", body: func() (any, []string) { return "recipient", []string{"Content-Type", "text/plain"} }, wantStatus: http.StatusBadRequest},
+		{name: "package not on a route", offRoute: true, body: func() (any, []string) { return nil, nil }, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, pkg := s.createActiveRoute(t)
+			if tt.offRoute {
+				pkg = s.createPackage(t)
+			}
+			body, headers := tt.body()
+
+			rec := s.do(t, http.MethodPost, "/api/v1/packages/"+pkg.ID.Hex()+"/deliver", body, headers...)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			// The delivery reaches the route stop, so the route completes
+			rec = s.do(t, http.MethodPatch, "/routes/"+route.ID.Hex()+"/status", UpdateRouteStatusRequest{Status: models.RouteStatusCompleted})
+			if rec.Code != http.StatusOK {
+				t.Fatalf("completing the route: status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			rec = s.do(t, http.MethodGet, "/api/v1/packages/"+pkg.ID.Hex()+"/pod", nil)
+			if got := rec.Code == http.StatusOK; got != tt.wantProof {
+				t.Fatalf("proof of delivery status = %d, want proof %v", rec.Code, tt.wantProof)
+			}
+			if tt.wantProof {
+				var proof services.DeliveryProof
+				decode(t, rec, &proof)
+				if proof.RecipientName != "Bob" {
+					t.Errorf("recipient = %q, want Bob", proof.RecipientName)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_GetPackagesByRoute(t *testing.T) {
+	s := newTestServer(t)
+	route, pkg := s.createRoute(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing route", id: route.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/packages/route/"+tt.id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var stops []models.PackageRoute
+				decode(t, rec, &stops)
+				if len(stops) != 1 || stops[0].PackageID != pkg.ID {
+					t.Errorf("stops = %+v, want the route's package", stops)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_GetPackageEvents(t *testing.T) {
+	s := newTestServer(t)
+	pkg := s.createPackage(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantEvents int
+	}{
+		{name: "existing package", id: pkg.ID.Hex(), wantStatus: http.StatusOK, wantEvents: 1},
+		{name: "unknown package", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/packages/"+tt.id+"/events", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var events []models.PackageEvent
+				decode(t, rec, &events)
+				if len(events) != tt.wantEvents {
+					t.Errorf("listed %d events, want %d", len(events), tt.wantEvents)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageHandler_GetProofOfDelivery(t *testing.T) {
+	s := newTestServer(t)
+	pending := s.createPackage(t)
+	_, delivered := s.createActiveRoute(t)
+	if _, err := s.routeService.DeliverPackage(context.Background(), delivered.ID, &services.DeliveryProof{RecipientName: "Bob"}); err != nil {
+		t.Fatalf("DeliverPackage: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "delivered with proof", id: delivered.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "not delivered", id: pending.ID.Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/api/v1/packages/"+tt.id+"/pod", nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
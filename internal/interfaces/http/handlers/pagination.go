@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+// PageQuery represents the query parameters shared by the list endpoints
+type PageQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"gte=0"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// pageRequest converts the query into the page to list, oldest first unless order=desc
+func (q PageQuery) pageRequest() repositories.PageRequest {
+	return repositories.PageRequest{
+		Cursor:     q.Cursor,
+		Limit:      q.Limit,
+		Descending: q.Order == "desc",
+	}
+}
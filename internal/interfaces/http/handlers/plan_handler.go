@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// PlanHandler handles HTTP requests for fleet plans
+type PlanHandler struct {
+	service *services.PlanningService
+}
+
+// NewPlanHandler creates a new plan handler
+func NewPlanHandler(service *services.PlanningService) *PlanHandler {
+	return &PlanHandler{
+		service: service,
+	}
+}
+
+// RegisterRoutes registers the plan routes
+func (h *PlanHandler) RegisterRoutes(router *gin.Engine) {
+	plans := router.Group("/plans")
+	{
+		plans.POST("", h.PreviewPlan)
+		plans.GET("/:id", h.GetPlan)
+		plans.POST("/:id/confirm", h.ConfirmPlan)
+	}
+}
+
+// PreviewPlanRequest represents the request body for previewing a fleet plan
+type PreviewPlanRequest struct {
+	Date          time.Time            `json:"date" binding:"required"`
+	StartLocation *models.Location     `json:"start_location"`
+	PackageIDs    []primitive.ObjectID `json:"package_ids" binding:"required,min=1"`
+}
+
+// PreviewPlan handles distributing packages across the active fleet without committing routes
+func (h *PlanHandler) PreviewPlan(c *gin.Context) {
+	var req PreviewPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	plan, err := h.service.PreviewPlan(c.Request.Context(), req.Date, req.StartLocation, req.PackageIDs)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, plan)
+}
+
+// GetPlan handles retrieving a plan by ID
+func (h *PlanHandler) GetPlan(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid plan ID")
+		return
+	}
+
+	plan, err := h.service.GetPlan(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// ConfirmPlan handles committing a previewed plan into routes
+func (h *PlanHandler) ConfirmPlan(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid plan ID")
+		return
+	}
+
+	plan, err := h.service.ConfirmPlan(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
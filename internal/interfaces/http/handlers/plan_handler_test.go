@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+func TestPlanHandler_PreviewPlan(t *testing.T) {
+	tests := []struct {
+		name       string
+		noDrivers  bool
+		body       func(pkg *models.Package) any
+		wantStatus int
+	}{
+		{name: "pending packages", body: func(pkg *models.Package) any {
+			return PreviewPlanRequest{Date: testDate, StartLocation: testDepot, PackageIDs: []primitive.ObjectID{pkg.ID}}
+		}, wantStatus: http.StatusCreated},
+		{name: "no active drivers", noDrivers: true, body: func(pkg *models.Package) any {
+			return PreviewPlanRequest{Date: testDate, StartLocation: testDepot, PackageIDs: []primitive.ObjectID{pkg.ID}}
+		}, wantStatus: http.StatusConflict},
+		{name: "no packages", body: func(*models.Package) any {
+			return PreviewPlanRequest{Date: testDate, StartLocation: testDepot, PackageIDs: []primitive.ObjectID{}}
+		}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			if !tt.noDrivers {
+				s.createDriver(t)
+			}
+			pkg := s.createPackage(t)
+
+			rec := s.do(t, http.MethodPost, "/plans", tt.body(pkg))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusCreated {
+				var plan models.Plan
+				decode(t, rec, &plan)
+				if plan.Status != models.PlanStatusDraft || len(plan.Routes) != 1 {
+					t.Errorf("plan = %+v, want a draft with one route", plan)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanHandler_GetPlan(t *testing.T) {
+	s := newTestServer(t)
+	s.createDriver(t)
+	plan, err := s.planningService.PreviewPlan(context.Background(), testDate, testDepot, []primitive.ObjectID{s.createPackage(t).ID})
+	if err != nil {
+		t.Fatalf("PreviewPlan: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing plan", id: plan.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown plan", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/plans/"+tt.id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestPlanHandler_ConfirmPlan(t *testing.T) {
+	tests := []struct {
+		name         string
+		confirmTwice bool
+		wantStatus   int
+	}{
+		{name: "draft plan", wantStatus: http.StatusOK},
+		{name: "already confirmed", confirmTwice: true, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createDriver(t)
+			plan, err := s.planningService.PreviewPlan(context.Background(), testDate, testDepot, []primitive.ObjectID{s.createPackage(t).ID})
+			if err != nil {
+				t.Fatalf("PreviewPlan: %v", err)
+			}
+			if tt.confirmTwice {
+				if _, err := s.planningService.ConfirmPlan(context.Background(), plan.ID); err != nil {
+					t.Fatalf("ConfirmPlan: %v", err)
+				}
+			}
+
+			rec := s.do(t, http.MethodPost, "/plans/"+plan.ID.Hex()+"/confirm", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var confirmed models.Plan
+				decode(t, rec, &confirmed)
+				if confirmed.Status != models.PlanStatusConfirmed || len(confirmed.RouteIDs) != 1 {
+					t.Errorf("plan = %+v, want it confirmed with one route", confirmed)
+				}
+			}
+		})
+	}
+}
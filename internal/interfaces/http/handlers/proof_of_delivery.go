@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+)
+
+// maxProofOfDeliveryBytes bounds the size of an uploaded proof of delivery
+const maxProofOfDeliveryBytes = 20 << 20
+
+// bindDeliveryProof reads the optional proof of delivery sent with a delivery, either as a
+// multipart form (recipient_name, latitude, longitude and signature/photo files) or as JSON
+// with base64-encoded attachments; it returns nil when the request carries no proof
+func bindDeliveryProof(c *gin.Context) (*services.DeliveryProof, error) {
+	if c.Request.ContentLength == 0 {
+		return nil, nil
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxProofOfDeliveryBytes)
+
+	switch c.ContentType() {
+	case binding.MIMEMultipartPOSTForm:
+		return bindMultipartDeliveryProof(c)
+	case binding.MIMEJSON:
+		var proof services.DeliveryProof
+		if err := c.ShouldBindJSON(&proof); err != nil {
+			return nil, err
+		}
+		return &proof, nil
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", c.ContentType())
+	}
+}
+
+func bindMultipartDeliveryProof(c *gin.Context) (*services.DeliveryProof, error) {
+	if err := c.Request.ParseMultipartForm(maxProofOfDeliveryBytes); err != nil {
+		return nil, err
+	}
+
+	proof := &services.DeliveryProof{
+		RecipientName: c.PostForm("recipient_name"),
+	}
+
+	if latitude, longitude := c.PostForm("latitude"), c.PostForm("longitude"); latitude != "" || longitude != "" {
+		lat, err := strconv.ParseFloat(latitude, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latitude: %w", err)
+		}
+		lon, err := strconv.ParseFloat(longitude, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid longitude: %w", err)
+		}
+		proof.Location = &models.Location{Latitude: lat, Longitude: lon}
+	}
+
+	var err error
+	if proof.Signature, err = formAttachment(c, "signature"); err != nil {
+		return nil, err
+	}
+	if proof.Photo, err = formAttachment(c, "photo"); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// formAttachment reads an uploaded file, returning nil when the field was not sent
+func formAttachment(c *gin.Context, field string) (*services.Attachment, error) {
+	header, err := c.FormFile(field)
+	if err == http.ErrMissingFile {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readAttachment(header)
+}
+
+func readAttachment(header *multipart.FileHeader) (*services.Attachment, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return &services.Attachment{
+		ContentType: header.Header.Get("Content-Type"),
+		Data:        data,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Arcanm/deliveryPlannerGolang/internal/application/services"
 	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
 )
 
 // RouteHandler handles HTTP requests for routes
@@ -29,35 +30,40 @@ func (h *RouteHandler) RegisterRoutes(router *gin.Engine) {
 	{
 		routes.POST("", h.CreateRoute)
 		routes.GET("/:id", h.GetRoute)
+		routes.GET("/:id/eta", h.GetRouteETA)
 		routes.GET("", h.ListRoutes)
 		routes.PUT("/:id", h.UpdateRoute)
 		routes.PATCH("/:id/status", h.UpdateRouteStatus)
 		routes.POST("/:id/packages", h.AddPackagesToRoute)
+		routes.POST("/:id/optimize", h.OptimizeRoute)
 		routes.PATCH("/:id/packages/:package_id/delivered", h.UpdatePackageDeliveryStatus)
+		routes.POST("/:id/packages/:package_id/failed", h.RecordFailedDeliveryAttempt)
 		routes.DELETE("/:id", h.DeleteRoute)
 	}
 }
 
 // CreateRouteRequest represents the request body for creating a route
 type CreateRouteRequest struct {
-	DriverID primitive.ObjectID `json:"driver_id" binding:"required"`
-	Date     time.Time          `json:"date" binding:"required"`
+	DriverID      primitive.ObjectID `json:"driver_id" binding:"required"`
+	Date          time.Time          `json:"date" binding:"required"`
+	StartLocation *models.Location   `json:"start_location"`
 }
 
 // CreateRoute handles the creation of a new route
 func (h *RouteHandler) CreateRoute(c *gin.Context) {
 	var req CreateRouteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	route, err := h.service.CreateRoute(c.Request.Context(), req.DriverID, req.Date)
+	route, err := h.service.CreateRoute(c.Request.Context(), req.DriverID, req.Date, req.StartLocation)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, route.Version)
 	c.JSON(http.StatusCreated, route)
 }
 
@@ -65,100 +71,148 @@ func (h *RouteHandler) CreateRoute(c *gin.Context) {
 func (h *RouteHandler) GetRoute(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	route, err := h.service.GetRoute(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
+		return
+	}
+
+	setETag(c, route.Version)
+	c.JSON(http.StatusOK, route)
+}
+
+// RouteETAResponse represents the response body with the arrival projections of a route
+type RouteETAResponse struct {
+	RouteID primitive.ObjectID    `json:"route_id"`
+	Status  models.RouteStatus    `json:"status"`
+	Stops   []models.PackageRoute `json:"stops"`
+}
+
+// GetRouteETA handles retrieving the planned and projected arrival at each stop of a route
+func (h *RouteHandler) GetRouteETA(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
-	if route == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "route not found"})
+
+	route, err := h.service.GetRouteETA(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, route)
+	c.JSON(http.StatusOK, RouteETAResponse{
+		RouteID: route.ID,
+		Status:  route.Status,
+		Stops:   route.Packages,
+	})
+}
+
+// ListRoutesQuery represents the query parameters for listing routes
+type ListRoutesQuery struct {
+	PageQuery
+	Status    models.RouteStatus `form:"status"`
+	DriverID  string             `form:"driver_id"`
+	DateFrom  time.Time          `form:"date_from"`
+	DateTo    time.Time          `form:"date_to"`
+	Delivered *bool              `form:"delivered"`
 }
 
-// ListRoutes handles retrieving all routes
+// ListRoutes handles retrieving one page of the routes matching the query filters
 func (h *RouteHandler) ListRoutes(c *gin.Context) {
-	routes, err := h.service.ListRoutes(c.Request.Context())
+	var query ListRoutesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := repositories.RouteFilter{
+		Status:    query.Status,
+		DateFrom:  query.DateFrom,
+		DateTo:    query.DateTo,
+		Delivered: query.Delivered,
+	}
+	if query.DriverID != "" {
+		driverID, err := primitive.ObjectIDFromHex(query.DriverID)
+		if err != nil {
+			respondBadRequest(c, "invalid driver ID")
+			return
+		}
+		filter.DriverID = driverID
+	}
+
+	page, err := h.service.ListRoutes(c.Request.Context(), filter, query.pageRequest())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, routes)
+	c.JSON(http.StatusOK, page)
 }
 
-// UpdateRouteRequest represents the request body for updating a route
+// UpdateRouteRequest represents the request body for updating a route; its estimates are
+// recalculated for the new driver and date
 type UpdateRouteRequest struct {
-	DriverID            primitive.ObjectID `json:"driver_id" binding:"required"`
-	Date                time.Time          `json:"date" binding:"required"`
-	EstimatedDistanceKm float64            `json:"estimated_distance_km"`
-	EstimatedTimeMin    int                `json:"estimated_time_min"`
+	DriverID primitive.ObjectID `json:"driver_id" binding:"required"`
+	Date     time.Time          `json:"date" binding:"required"`
 }
 
-// UpdateRoute handles updating a route
+// UpdateRoute handles updating a route, only if it is still at the version in If-Match when given
 func (h *RouteHandler) UpdateRoute(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	var req UpdateRouteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	route, err := h.service.GetRoute(c.Request.Context(), id)
+	version, err := ifMatchVersion(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	if route == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "route not found"})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	route.DriverID = req.DriverID
-	route.Date = req.Date
-	route.EstimatedDistanceKm = req.EstimatedDistanceKm
-	route.EstimatedTimeMin = req.EstimatedTimeMin
-
-	if err := h.service.UpdateRoute(c.Request.Context(), route); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	route, err := h.service.UpdateRoute(c.Request.Context(), id, version, req.DriverID, req.Date)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, route.Version)
 	c.JSON(http.StatusOK, route)
 }
 
 // UpdateRouteStatusRequest represents the request body for updating a route's status
 type UpdateRouteStatusRequest struct {
-	Status models.RouteStatus `json:"status" binding:"required"`
+	Status models.RouteStatus `json:"status" binding:"required,oneof=pending active completed cancelled suspended"`
 }
 
 // UpdateRouteStatus handles updating a route's status
 func (h *RouteHandler) UpdateRouteStatus(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	var req UpdateRouteStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	if err := h.service.UpdateRouteStatus(c.Request.Context(), id, req.Status); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -174,56 +228,126 @@ type AddPackagesToRouteRequest struct {
 func (h *RouteHandler) AddPackagesToRoute(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	var req AddPackagesToRouteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	if err := h.service.AddPackagesToRoute(c.Request.Context(), id, req.PackageIDs); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	c.Status(http.StatusOK)
 }
 
-// UpdatePackageDeliveryStatus handles updating a package's delivery status in a route
+// OptimizeRouteResponse represents the response body after optimizing a route
+type OptimizeRouteResponse struct {
+	Route            *models.Route `json:"route"`
+	DistanceBeforeKm float64       `json:"distance_before_km"`
+	DistanceAfterKm  float64       `json:"distance_after_km"`
+}
+
+// OptimizeRoute handles re-sequencing the stops of a route
+func (h *RouteHandler) OptimizeRoute(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid route ID")
+		return
+	}
+
+	result, err := h.service.OptimizeRoute(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, OptimizeRouteResponse{
+		Route:            result.Route,
+		DistanceBeforeKm: result.DistanceBeforeKm,
+		DistanceAfterKm:  result.DistanceAfterKm,
+	})
+}
+
+// UpdatePackageDeliveryStatus handles marking a package as delivered in a route, responding with the updated route
 func (h *RouteHandler) UpdatePackageDeliveryStatus(c *gin.Context) {
 	routeID, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	packageID, err := primitive.ObjectIDFromHex(c.Param("package_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid package ID"})
+		respondBadRequest(c, "invalid package ID")
 		return
 	}
 
-	if err := h.service.UpdatePackageDeliveryStatus(c.Request.Context(), routeID, packageID, true); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	proof, err := bindDeliveryProof(c)
+	if err != nil {
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	c.Status(http.StatusOK)
+	route, err := h.service.UpdatePackageDeliveryStatus(c.Request.Context(), routeID, packageID, true, proof)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, route)
+}
+
+// FailedDeliveryAttemptRequest represents the request body for recording a failed delivery attempt
+type FailedDeliveryAttemptRequest struct {
+	Reason models.FailureReason `json:"reason" binding:"required,oneof=customer_not_home address_not_found refused damaged access_restricted other"`
+	Note   string               `json:"note"`
+}
+
+// RecordFailedDeliveryAttempt handles recording a failed delivery attempt at a route stop
+func (h *RouteHandler) RecordFailedDeliveryAttempt(c *gin.Context) {
+	routeID, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		respondBadRequest(c, "invalid route ID")
+		return
+	}
+
+	packageID, err := primitive.ObjectIDFromHex(c.Param("package_id"))
+	if err != nil {
+		respondBadRequest(c, "invalid package ID")
+		return
+	}
+
+	var req FailedDeliveryAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pkg, err := h.service.RecordFailedDeliveryAttempt(c.Request.Context(), routeID, packageID, req.Reason, req.Note)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pkg)
 }
 
 // DeleteRoute handles deleting a route
 func (h *RouteHandler) DeleteRoute(c *gin.Context) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid route ID"})
+		respondBadRequest(c, "invalid route ID")
 		return
 	}
 
 	if err := h.service.DeleteRoute(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -0,0 +1,428 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/models"
+	"github.com/Arcanm/deliveryPlannerGolang/internal/domain/repositories"
+)
+
+func TestRouteHandler_CreateRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       func(s *testServer) any
+		wantStatus int
+	}{
+		{name: "active driver", body: func(s *testServer) any {
+			return CreateRouteRequest{DriverID: s.createDriver(t).ID, Date: testDate, StartLocation: testDepot}
+		}, wantStatus: http.StatusCreated},
+		{name: "unknown driver", body: func(*testServer) any {
+			return CreateRouteRequest{DriverID: primitive.NewObjectID(), Date: testDate}
+		}, wantStatus: http.StatusNotFound},
+		{name: "missing date", body: func(s *testServer) any {
+			return map[string]string{"driver_id": s.createDriver(t).ID.Hex()}
+		}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+
+			rec := s.do(t, http.MethodPost, "/routes", tt.body(s))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusCreated {
+				var route models.Route
+				decode(t, rec, &route)
+				if route.ID.IsZero() || route.Status != models.RouteStatusPending {
+					t.Errorf("route = %+v, want a stored pending route", route)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteHandler_GetRoute(t *testing.T) {
+	s := newTestServer(t)
+	route, _ := s.createRoute(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing route", id: route.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/routes/"+tt.id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestRouteHandler_GetRouteETA(t *testing.T) {
+	s := newTestServer(t)
+	route, pkg := s.createActiveRoute(t)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "active route", id: route.ID.Hex(), wantStatus: http.StatusOK},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/routes/"+tt.id+"/eta", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var eta RouteETAResponse
+			decode(t, rec, &eta)
+			if eta.Status != models.RouteStatusActive || len(eta.Stops) != 1 || eta.Stops[0].PackageID != pkg.ID || eta.Stops[0].ProjectedArrival == nil {
+				t.Errorf("eta = %+v, want one projected stop", eta)
+			}
+		})
+	}
+}
+
+func TestRouteHandler_ListRoutes(t *testing.T) {
+	s := newTestServer(t)
+	route, _ := s.createRoute(t)
+	s.createRoute(t)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCount  int
+	}{
+		{name: "no filter", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "by driver", query: "?driver_id=" + route.DriverID.Hex(), wantStatus: http.StatusOK, wantCount: 1},
+		{name: "by status", query: "?status=active", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "on the delivery day", query: "?date_from=2026-03-02T00:00:00Z&date_to=2026-03-02T23:59:59Z", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "with stops to deliver", query: "?delivered=false", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "newest first", query: "?order=desc&limit=1", wantStatus: http.StatusOK, wantCount: 1},
+		{name: "malformed driver id", query: "?driver_id=nope", wantStatus: http.StatusBadRequest},
+		{name: "unknown status", query: "?status=lost", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(t, http.MethodGet, "/routes"+tt.query, nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var page repositories.Page[models.Route]
+			decode(t, rec, &page)
+			if len(page.Items) != tt.wantCount {
+				t.Errorf("listed %d routes, want %d", len(page.Items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestRouteHandler_UpdateRoute(t *testing.T) {
+	nextDay := testDate.AddDate(0, 0, 1)
+	update := func(driverID primitive.ObjectID) any {
+		return UpdateRouteRequest{DriverID: driverID, Date: nextDay}
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		body       func(driverID primitive.ObjectID) any
+		ifMatch    func(version int64) string
+		wantStatus int
+	}{
+		{name: "valid update", body: update, wantStatus: http.StatusOK},
+		{
+			name: "client estimates ignored",
+			body: func(driverID primitive.ObjectID) any {
+				return map[string]any{"driver_id": driverID, "date": nextDay, "estimated_distance_km": 999, "estimated_time_min": 999}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{name: "current If-Match", body: update, ifMatch: etag, wantStatus: http.StatusOK},
+		{name: "stale If-Match", body: update, ifMatch: func(version int64) string { return etag(version - 1) }, wantStatus: http.StatusConflict},
+		{name: "malformed If-Match", body: update, ifMatch: func(int64) string { return "v1" }, wantStatus: http.StatusBadRequest},
+		{name: "unknown route", id: primitive.NewObjectID().Hex(), body: update, wantStatus: http.StatusNotFound},
+		{name: "unknown driver", body: func(primitive.ObjectID) any { return update(primitive.NewObjectID()) }, wantStatus: http.StatusNotFound},
+		{name: "missing date", body: func(driverID primitive.ObjectID) any { return map[string]any{"driver_id": driverID} }, wantStatus: http.StatusBadRequest},
+		{name: "malformed id", id: "nope", body: update, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			driver := s.createDriver(t)
+			id := tt.id
+			var headers []string
+			if id == "" {
+				route, _ := s.createRoute(t)
+				id = route.ID.Hex()
+				if tt.ifMatch != nil {
+					stored, err := s.routeService.GetRoute(context.Background(), route.ID)
+					if err != nil {
+						t.Fatalf("GetRoute: %v", err)
+					}
+					headers = []string{"If-Match", tt.ifMatch(stored.Version)}
+				}
+			}
+
+			rec := s.do(t, http.MethodPut, "/routes/"+id, tt.body(driver.ID), headers...)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var route models.Route
+				decode(t, rec, &route)
+				if route.DriverID != driver.ID || !route.Date.Equal(nextDay) {
+					t.Errorf("route = %+v, want it reassigned to %s on %v", route, driver.ID.Hex(), nextDay)
+				}
+				if route.EstimatedDistanceKm == 0 || route.EstimatedDistanceKm == 999 || route.EstimatedTimeMin == 999 {
+					t.Errorf("route = %+v, want the estimates calculated by the server", route)
+				}
+				if got := rec.Header().Get("ETag"); got != etag(route.Version) {
+					t.Errorf("ETag = %q, want %q", got, etag(route.Version))
+				}
+			}
+		})
+	}
+}
+
+func TestRouteHandler_UpdateRouteStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      bool
+		status     models.RouteStatus
+		wantStatus int
+	}{
+		{name: "start a pending route", status: models.RouteStatusActive, wantStatus: http.StatusOK},
+		{name: "complete a pending route", status: models.RouteStatusCompleted, wantStatus: http.StatusConflict},
+		{name: "complete with pending packages", start: true, status: models.RouteStatusCompleted, wantStatus: http.StatusConflict},
+		{name: "unknown status", status: "paused", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			if tt.start {
+				route, _ = s.createActiveRoute(t)
+			}
+
+			rec := s.do(t, http.MethodPatch, "/routes/"+route.ID.Hex()+"/status", UpdateRouteStatusRequest{Status: tt.status})
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestRouteHandler_AddPackagesToRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      bool
+		wantStatus int
+	}{
+		{name: "pending route", wantStatus: http.StatusOK},
+		{name: "route already started", start: true, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			if tt.start {
+				route, _ = s.createActiveRoute(t)
+			}
+			pkg := s.createPackage(t)
+
+			rec := s.do(t, http.MethodPost, "/routes/"+route.ID.Hex()+"/packages", AddPackagesToRouteRequest{PackageIDs: []primitive.ObjectID{pkg.ID}})
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+
+	t.Run("missing package ids", func(t *testing.T) {
+		s := newTestServer(t)
+		route, _ := s.createRoute(t)
+
+		rec := s.do(t, http.MethodPost, "/routes/"+route.ID.Hex()+"/packages", map[string]any{})
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestRouteHandler_OptimizeRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      bool
+		wantStatus int
+	}{
+		{name: "pending route", wantStatus: http.StatusOK},
+		{name: "active route", start: true, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, _ := s.createRoute(t)
+			if tt.start {
+				route, _ = s.createActiveRoute(t)
+			}
+
+			rec := s.do(t, http.MethodPost, "/routes/"+route.ID.Hex()+"/optimize", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var result OptimizeRouteResponse
+				decode(t, rec, &result)
+				if result.Route == nil || result.Route.ID != route.ID {
+					t.Errorf("result = %+v, want the optimized route", result)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteHandler_UpdatePackageDeliveryStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      bool
+		body       any
+		wantStatus int
+	}{
+		{name: "active route", start: true, wantStatus: http.StatusOK},
+		{name: "active route with proof", start: true, body: map[string]string{"recipient_name": "Bob"}, wantStatus: http.StatusOK},
+		{name: "route not started", wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, pkg := s.createRoute(t)
+			if tt.start {
+				route, pkg = s.createActiveRoute(t)
+			}
+
+			rec := s.do(t, http.MethodPatch, "/routes/"+route.ID.Hex()+"/packages/"+pkg.ID.Hex()+"/delivered", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var updated models.Route
+			decode(t, rec, &updated)
+			if stop := updated.Stop(pkg.ID); stop == nil || !stop.Delivered || stop.DeliveryTimestamp == nil {
+				t.Errorf("returned stop = %+v, want it delivered with a timestamp", stop)
+			}
+
+			delivered, err := s.packageService.GetPackage(context.Background(), pkg.ID)
+			if err != nil {
+				t.Fatalf("GetPackage: %v", err)
+			}
+			if !delivered.Delivered {
+				t.Error("package not marked delivered")
+			}
+		})
+	}
+
+	t.Run("malformed package id", func(t *testing.T) {
+		s := newTestServer(t)
+		route, _ := s.createActiveRoute(t)
+
+		rec := s.do(t, http.MethodPatch, "/routes/"+route.ID.Hex()+"/packages/nope/delivered", nil)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestRouteHandler_RecordFailedDeliveryAttempt(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      bool
+		body       any
+		wantStatus int
+	}{
+		{name: "active route", start: true, body: FailedDeliveryAttemptRequest{Reason: models.FailureReasonCustomerNotHome, Note: "no answer"}, wantStatus: http.StatusOK},
+		{name: "route not started", body: FailedDeliveryAttemptRequest{Reason: models.FailureReasonRefused}, wantStatus: http.StatusConflict},
+		{name: "unknown reason", start: true, body: FailedDeliveryAttemptRequest{Reason: "dog"}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			route, pkg := s.createRoute(t)
+			if tt.start {
+				route, pkg = s.createActiveRoute(t)
+			}
+
+			rec := s.do(t, http.MethodPost, "/routes/"+route.ID.Hex()+"/packages/"+pkg.ID.Hex()+"/failed", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				var failed models.Package
+				decode(t, rec, &failed)
+				if failed.FailedAttempts != 1 || failed.Status != models.PackageStatusPending {
+					t.Errorf("package = %s after %d attempts, want pending after 1", failed.Status, failed.FailedAttempts)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteHandler_DeleteRoute(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "existing route", wantStatus: http.StatusNoContent},
+		{name: "malformed id", id: "nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			id := tt.id
+			if id == "" {
+				route, _ := s.createRoute(t)
+				id = route.ID.Hex()
+			}
+
+			rec := s.do(t, http.MethodDelete, "/routes/"+id, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
@@ -74,24 +74,83 @@ func (VehicleType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_driver_proto_rawDescGZIP(), []int{0}
 }
 
+// VehicleCapacity represents the maximum load a vehicle can carry
+type VehicleCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxWeightKg float64 `protobuf:"fixed64,1,opt,name=max_weight_kg,json=maxWeightKg,proto3" json:"max_weight_kg,omitempty"`
+	MaxVolumeM3 float64 `protobuf:"fixed64,2,opt,name=max_volume_m3,json=maxVolumeM3,proto3" json:"max_volume_m3,omitempty"`
+}
+
+func (x *VehicleCapacity) Reset() {
+	*x = VehicleCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_driver_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleCapacity) ProtoMessage() {}
+
+func (x *VehicleCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleCapacity.ProtoReflect.Descriptor instead.
+func (*VehicleCapacity) Descriptor() ([]byte, []int) {
+	return file_proto_driver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VehicleCapacity) GetMaxWeightKg() float64 {
+	if x != nil {
+		return x.MaxWeightKg
+	}
+	return 0
+}
+
+func (x *VehicleCapacity) GetMaxVolumeM3() float64 {
+	if x != nil {
+		return x.MaxVolumeM3
+	}
+	return 0
+}
+
 // Driver represents a delivery driver
 type Driver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	VehicleType VehicleType            `protobuf:"varint,3,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
-	Active      bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VehicleType      VehicleType            `protobuf:"varint,3,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
+	Active           bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CapacityOverride *VehicleCapacity       `protobuf:"bytes,7,opt,name=capacity_override,json=capacityOverride,proto3" json:"capacity_override,omitempty"`
+	// Number of changes stored for the driver; pass it back in updates to detect concurrent edits
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Driver) Reset() {
 	*x = Driver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[0]
+		mi := &file_proto_driver_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -104,7 +163,7 @@ func (x *Driver) String() string {
 func (*Driver) ProtoMessage() {}
 
 func (x *Driver) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[0]
+	mi := &file_proto_driver_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +176,7 @@ func (x *Driver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Driver.ProtoReflect.Descriptor instead.
 func (*Driver) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{0}
+	return file_proto_driver_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Driver) GetId() string {
@@ -162,20 +221,35 @@ func (x *Driver) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Driver) GetCapacityOverride() *VehicleCapacity {
+	if x != nil {
+		return x.CapacityOverride
+	}
+	return nil
+}
+
+func (x *Driver) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateDriverRequest represents the request to create a driver
 type CreateDriverRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	VehicleType VehicleType `protobuf:"varint,2,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
+	Name             string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VehicleType      VehicleType      `protobuf:"varint,2,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
+	CapacityOverride *VehicleCapacity `protobuf:"bytes,3,opt,name=capacity_override,json=capacityOverride,proto3" json:"capacity_override,omitempty"`
 }
 
 func (x *CreateDriverRequest) Reset() {
 	*x = CreateDriverRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[1]
+		mi := &file_proto_driver_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -188,7 +262,7 @@ func (x *CreateDriverRequest) String() string {
 func (*CreateDriverRequest) ProtoMessage() {}
 
 func (x *CreateDriverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[1]
+	mi := &file_proto_driver_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,7 +275,7 @@ func (x *CreateDriverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDriverRequest.ProtoReflect.Descriptor instead.
 func (*CreateDriverRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{1}
+	return file_proto_driver_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateDriverRequest) GetName() string {
@@ -218,6 +292,13 @@ func (x *CreateDriverRequest) GetVehicleType() VehicleType {
 	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
 }
 
+func (x *CreateDriverRequest) GetCapacityOverride() *VehicleCapacity {
+	if x != nil {
+		return x.CapacityOverride
+	}
+	return nil
+}
+
 // CreateDriverResponse represents the response after creating a driver
 type CreateDriverResponse struct {
 	state         protoimpl.MessageState
@@ -230,7 +311,7 @@ type CreateDriverResponse struct {
 func (x *CreateDriverResponse) Reset() {
 	*x = CreateDriverResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[2]
+		mi := &file_proto_driver_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -243,7 +324,7 @@ func (x *CreateDriverResponse) String() string {
 func (*CreateDriverResponse) ProtoMessage() {}
 
 func (x *CreateDriverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[2]
+	mi := &file_proto_driver_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +337,7 @@ func (x *CreateDriverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDriverResponse.ProtoReflect.Descriptor instead.
 func (*CreateDriverResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{2}
+	return file_proto_driver_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateDriverResponse) GetDriver() *Driver {
@@ -278,7 +359,7 @@ type GetDriverRequest struct {
 func (x *GetDriverRequest) Reset() {
 	*x = GetDriverRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[3]
+		mi := &file_proto_driver_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +372,7 @@ func (x *GetDriverRequest) String() string {
 func (*GetDriverRequest) ProtoMessage() {}
 
 func (x *GetDriverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[3]
+	mi := &file_proto_driver_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +385,7 @@ func (x *GetDriverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDriverRequest.ProtoReflect.Descriptor instead.
 func (*GetDriverRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{3}
+	return file_proto_driver_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetDriverRequest) GetId() string {
@@ -326,7 +407,7 @@ type GetDriverResponse struct {
 func (x *GetDriverResponse) Reset() {
 	*x = GetDriverResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[4]
+		mi := &file_proto_driver_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +420,7 @@ func (x *GetDriverResponse) String() string {
 func (*GetDriverResponse) ProtoMessage() {}
 
 func (x *GetDriverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[4]
+	mi := &file_proto_driver_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +433,7 @@ func (x *GetDriverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDriverResponse.ProtoReflect.Descriptor instead.
 func (*GetDriverResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{4}
+	return file_proto_driver_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetDriverResponse) GetDriver() *Driver {
@@ -367,12 +448,23 @@ type ListDriversRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of drivers to return; defaults to 50, capped at 200
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Cursor returned as next_page_token by a previous call; empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// List the newest drivers first
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Only list drivers using this vehicle type
+	VehicleType VehicleType `protobuf:"varint,4,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
+	// Only list active (true) or inactive (false) drivers
+	Active *bool `protobuf:"varint,5,opt,name=active,proto3,oneof" json:"active,omitempty"`
 }
 
 func (x *ListDriversRequest) Reset() {
 	*x = ListDriversRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[5]
+		mi := &file_proto_driver_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +477,7 @@ func (x *ListDriversRequest) String() string {
 func (*ListDriversRequest) ProtoMessage() {}
 
 func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[5]
+	mi := &file_proto_driver_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +490,42 @@ func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDriversRequest.ProtoReflect.Descriptor instead.
 func (*ListDriversRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{5}
+	return file_proto_driver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDriversRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDriversRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListDriversRequest) GetVehicleType() VehicleType {
+	if x != nil {
+		return x.VehicleType
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
+}
+
+func (x *ListDriversRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
 }
 
 // ListDriversResponse represents the response after listing drivers
@@ -408,12 +535,14 @@ type ListDriversResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Drivers []*Driver `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	// Cursor for the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListDriversResponse) Reset() {
 	*x = ListDriversResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[6]
+		mi := &file_proto_driver_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -426,7 +555,7 @@ func (x *ListDriversResponse) String() string {
 func (*ListDriversResponse) ProtoMessage() {}
 
 func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[6]
+	mi := &file_proto_driver_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +568,7 @@ func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDriversResponse.ProtoReflect.Descriptor instead.
 func (*ListDriversResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{6}
+	return file_proto_driver_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListDriversResponse) GetDrivers() []*Driver {
@@ -449,22 +578,32 @@ func (x *ListDriversResponse) GetDrivers() []*Driver {
 	return nil
 }
 
+func (x *ListDriversResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // UpdateDriverRequest represents the request to update a driver
 type UpdateDriverRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	VehicleType VehicleType `protobuf:"varint,3,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
-	Active      bool        `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Id               string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VehicleType      VehicleType      `protobuf:"varint,3,opt,name=vehicle_type,json=vehicleType,proto3,enum=deliveryplanner.VehicleType" json:"vehicle_type,omitempty"`
+	Active           bool             `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CapacityOverride *VehicleCapacity `protobuf:"bytes,5,opt,name=capacity_override,json=capacityOverride,proto3" json:"capacity_override,omitempty"`
+	// Version the update is based on; it fails if the driver changed since. 0 skips the check
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateDriverRequest) Reset() {
 	*x = UpdateDriverRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[7]
+		mi := &file_proto_driver_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -477,7 +616,7 @@ func (x *UpdateDriverRequest) String() string {
 func (*UpdateDriverRequest) ProtoMessage() {}
 
 func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[7]
+	mi := &file_proto_driver_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +629,7 @@ func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDriverRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDriverRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{7}
+	return file_proto_driver_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateDriverRequest) GetId() string {
@@ -521,6 +660,20 @@ func (x *UpdateDriverRequest) GetActive() bool {
 	return false
 }
 
+func (x *UpdateDriverRequest) GetCapacityOverride() *VehicleCapacity {
+	if x != nil {
+		return x.CapacityOverride
+	}
+	return nil
+}
+
+func (x *UpdateDriverRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // UpdateDriverResponse represents the response after updating a driver
 type UpdateDriverResponse struct {
 	state         protoimpl.MessageState
@@ -533,7 +686,7 @@ type UpdateDriverResponse struct {
 func (x *UpdateDriverResponse) Reset() {
 	*x = UpdateDriverResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[8]
+		mi := &file_proto_driver_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -546,7 +699,7 @@ func (x *UpdateDriverResponse) String() string {
 func (*UpdateDriverResponse) ProtoMessage() {}
 
 func (x *UpdateDriverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[8]
+	mi := &file_proto_driver_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +712,7 @@ func (x *UpdateDriverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDriverResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDriverResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{8}
+	return file_proto_driver_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateDriverResponse) GetDriver() *Driver {
@@ -581,7 +734,7 @@ type DeleteDriverRequest struct {
 func (x *DeleteDriverRequest) Reset() {
 	*x = DeleteDriverRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[9]
+		mi := &file_proto_driver_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -594,7 +747,7 @@ func (x *DeleteDriverRequest) String() string {
 func (*DeleteDriverRequest) ProtoMessage() {}
 
 func (x *DeleteDriverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[9]
+	mi := &file_proto_driver_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +760,7 @@ func (x *DeleteDriverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDriverRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDriverRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{9}
+	return file_proto_driver_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteDriverRequest) GetId() string {
@@ -627,7 +780,7 @@ type DeleteDriverResponse struct {
 func (x *DeleteDriverResponse) Reset() {
 	*x = DeleteDriverResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[10]
+		mi := &file_proto_driver_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -640,7 +793,7 @@ func (x *DeleteDriverResponse) String() string {
 func (*DeleteDriverResponse) ProtoMessage() {}
 
 func (x *DeleteDriverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[10]
+	mi := &file_proto_driver_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +806,7 @@ func (x *DeleteDriverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDriverResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDriverResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{10}
+	return file_proto_driver_proto_rawDescGZIP(), []int{11}
 }
 
 // GetDriverRoutesRequest represents the request to get a driver's routes
@@ -668,7 +821,7 @@ type GetDriverRoutesRequest struct {
 func (x *GetDriverRoutesRequest) Reset() {
 	*x = GetDriverRoutesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[11]
+		mi := &file_proto_driver_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -681,7 +834,7 @@ func (x *GetDriverRoutesRequest) String() string {
 func (*GetDriverRoutesRequest) ProtoMessage() {}
 
 func (x *GetDriverRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[11]
+	mi := &file_proto_driver_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +847,7 @@ func (x *GetDriverRoutesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDriverRoutesRequest.ProtoReflect.Descriptor instead.
 func (*GetDriverRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{11}
+	return file_proto_driver_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetDriverRoutesRequest) GetDriverId() string {
@@ -716,7 +869,7 @@ type GetDriverRoutesResponse struct {
 func (x *GetDriverRoutesResponse) Reset() {
 	*x = GetDriverRoutesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_driver_proto_msgTypes[12]
+		mi := &file_proto_driver_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -729,7 +882,7 @@ func (x *GetDriverRoutesResponse) String() string {
 func (*GetDriverRoutesResponse) ProtoMessage() {}
 
 func (x *GetDriverRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_driver_proto_msgTypes[12]
+	mi := &file_proto_driver_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +895,7 @@ func (x *GetDriverRoutesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDriverRoutesResponse.ProtoReflect.Descriptor instead.
 func (*GetDriverRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_driver_proto_rawDescGZIP(), []int{12}
+	return file_proto_driver_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetDriverRoutesResponse) GetRoutes() []*Route {
@@ -760,120 +913,159 @@ var file_proto_driver_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x06, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69,
-	0x63, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65,
-	0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x0f, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d,
+	0x33, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4d, 0x33, 0x22, 0xe4, 0x02, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52,
+	0x10, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a,
+	0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x70, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x07, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4d, 0x0a,
+	0x11, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
 	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x2a, 0x70, 0x0a, 0x0b, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x42, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x48,
-	0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x4e, 0x10, 0x02, 0x12,
-	0x16, 0x0a, 0x12, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x54, 0x52, 0x55, 0x43, 0x4b, 0x10, 0x03, 0x32, 0xc6, 0x04, 0x0a, 0x0d, 0x44, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e,
+	0x6c, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22,
+	0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x2a, 0x70, 0x0a, 0x0b, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49,
+	0x4b, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x45,
+	0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x43, 0x4b,
+	0x10, 0x03, 0x32, 0xc6, 0x04, 0x0a, 0x0d, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x21, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
 	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41,
-	0x72, 0x63, 0x61, 0x6e, 0x6d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x63, 0x61, 0x6e, 0x6d,
+	0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -889,53 +1081,58 @@ func file_proto_driver_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_driver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_driver_proto_goTypes = []interface{}{
 	(VehicleType)(0),                // 0: deliveryplanner.VehicleType
-	(*Driver)(nil),                  // 1: deliveryplanner.Driver
-	(*CreateDriverRequest)(nil),     // 2: deliveryplanner.CreateDriverRequest
-	(*CreateDriverResponse)(nil),    // 3: deliveryplanner.CreateDriverResponse
-	(*GetDriverRequest)(nil),        // 4: deliveryplanner.GetDriverRequest
-	(*GetDriverResponse)(nil),       // 5: deliveryplanner.GetDriverResponse
-	(*ListDriversRequest)(nil),      // 6: deliveryplanner.ListDriversRequest
-	(*ListDriversResponse)(nil),     // 7: deliveryplanner.ListDriversResponse
-	(*UpdateDriverRequest)(nil),     // 8: deliveryplanner.UpdateDriverRequest
-	(*UpdateDriverResponse)(nil),    // 9: deliveryplanner.UpdateDriverResponse
-	(*DeleteDriverRequest)(nil),     // 10: deliveryplanner.DeleteDriverRequest
-	(*DeleteDriverResponse)(nil),    // 11: deliveryplanner.DeleteDriverResponse
-	(*GetDriverRoutesRequest)(nil),  // 12: deliveryplanner.GetDriverRoutesRequest
-	(*GetDriverRoutesResponse)(nil), // 13: deliveryplanner.GetDriverRoutesResponse
-	(*timestamppb.Timestamp)(nil),   // 14: google.protobuf.Timestamp
-	(*Route)(nil),                   // 15: deliveryplanner.Route
+	(*VehicleCapacity)(nil),         // 1: deliveryplanner.VehicleCapacity
+	(*Driver)(nil),                  // 2: deliveryplanner.Driver
+	(*CreateDriverRequest)(nil),     // 3: deliveryplanner.CreateDriverRequest
+	(*CreateDriverResponse)(nil),    // 4: deliveryplanner.CreateDriverResponse
+	(*GetDriverRequest)(nil),        // 5: deliveryplanner.GetDriverRequest
+	(*GetDriverResponse)(nil),       // 6: deliveryplanner.GetDriverResponse
+	(*ListDriversRequest)(nil),      // 7: deliveryplanner.ListDriversRequest
+	(*ListDriversResponse)(nil),     // 8: deliveryplanner.ListDriversResponse
+	(*UpdateDriverRequest)(nil),     // 9: deliveryplanner.UpdateDriverRequest
+	(*UpdateDriverResponse)(nil),    // 10: deliveryplanner.UpdateDriverResponse
+	(*DeleteDriverRequest)(nil),     // 11: deliveryplanner.DeleteDriverRequest
+	(*DeleteDriverResponse)(nil),    // 12: deliveryplanner.DeleteDriverResponse
+	(*GetDriverRoutesRequest)(nil),  // 13: deliveryplanner.GetDriverRoutesRequest
+	(*GetDriverRoutesResponse)(nil), // 14: deliveryplanner.GetDriverRoutesResponse
+	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
+	(*Route)(nil),                   // 16: deliveryplanner.Route
 }
 var file_proto_driver_proto_depIdxs = []int32{
 	0,  // 0: deliveryplanner.Driver.vehicle_type:type_name -> deliveryplanner.VehicleType
-	14, // 1: deliveryplanner.Driver.created_at:type_name -> google.protobuf.Timestamp
-	14, // 2: deliveryplanner.Driver.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: deliveryplanner.CreateDriverRequest.vehicle_type:type_name -> deliveryplanner.VehicleType
-	1,  // 4: deliveryplanner.CreateDriverResponse.driver:type_name -> deliveryplanner.Driver
-	1,  // 5: deliveryplanner.GetDriverResponse.driver:type_name -> deliveryplanner.Driver
-	1,  // 6: deliveryplanner.ListDriversResponse.drivers:type_name -> deliveryplanner.Driver
-	0,  // 7: deliveryplanner.UpdateDriverRequest.vehicle_type:type_name -> deliveryplanner.VehicleType
-	1,  // 8: deliveryplanner.UpdateDriverResponse.driver:type_name -> deliveryplanner.Driver
-	15, // 9: deliveryplanner.GetDriverRoutesResponse.routes:type_name -> deliveryplanner.Route
-	2,  // 10: deliveryplanner.DriverService.CreateDriver:input_type -> deliveryplanner.CreateDriverRequest
-	4,  // 11: deliveryplanner.DriverService.GetDriver:input_type -> deliveryplanner.GetDriverRequest
-	6,  // 12: deliveryplanner.DriverService.ListDrivers:input_type -> deliveryplanner.ListDriversRequest
-	8,  // 13: deliveryplanner.DriverService.UpdateDriver:input_type -> deliveryplanner.UpdateDriverRequest
-	10, // 14: deliveryplanner.DriverService.DeleteDriver:input_type -> deliveryplanner.DeleteDriverRequest
-	12, // 15: deliveryplanner.DriverService.GetDriverRoutes:input_type -> deliveryplanner.GetDriverRoutesRequest
-	3,  // 16: deliveryplanner.DriverService.CreateDriver:output_type -> deliveryplanner.CreateDriverResponse
-	5,  // 17: deliveryplanner.DriverService.GetDriver:output_type -> deliveryplanner.GetDriverResponse
-	7,  // 18: deliveryplanner.DriverService.ListDrivers:output_type -> deliveryplanner.ListDriversResponse
-	9,  // 19: deliveryplanner.DriverService.UpdateDriver:output_type -> deliveryplanner.UpdateDriverResponse
-	11, // 20: deliveryplanner.DriverService.DeleteDriver:output_type -> deliveryplanner.DeleteDriverResponse
-	13, // 21: deliveryplanner.DriverService.GetDriverRoutes:output_type -> deliveryplanner.GetDriverRoutesResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	15, // 1: deliveryplanner.Driver.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: deliveryplanner.Driver.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: deliveryplanner.Driver.capacity_override:type_name -> deliveryplanner.VehicleCapacity
+	0,  // 4: deliveryplanner.CreateDriverRequest.vehicle_type:type_name -> deliveryplanner.VehicleType
+	1,  // 5: deliveryplanner.CreateDriverRequest.capacity_override:type_name -> deliveryplanner.VehicleCapacity
+	2,  // 6: deliveryplanner.CreateDriverResponse.driver:type_name -> deliveryplanner.Driver
+	2,  // 7: deliveryplanner.GetDriverResponse.driver:type_name -> deliveryplanner.Driver
+	0,  // 8: deliveryplanner.ListDriversRequest.vehicle_type:type_name -> deliveryplanner.VehicleType
+	2,  // 9: deliveryplanner.ListDriversResponse.drivers:type_name -> deliveryplanner.Driver
+	0,  // 10: deliveryplanner.UpdateDriverRequest.vehicle_type:type_name -> deliveryplanner.VehicleType
+	1,  // 11: deliveryplanner.UpdateDriverRequest.capacity_override:type_name -> deliveryplanner.VehicleCapacity
+	2,  // 12: deliveryplanner.UpdateDriverResponse.driver:type_name -> deliveryplanner.Driver
+	16, // 13: deliveryplanner.GetDriverRoutesResponse.routes:type_name -> deliveryplanner.Route
+	3,  // 14: deliveryplanner.DriverService.CreateDriver:input_type -> deliveryplanner.CreateDriverRequest
+	5,  // 15: deliveryplanner.DriverService.GetDriver:input_type -> deliveryplanner.GetDriverRequest
+	7,  // 16: deliveryplanner.DriverService.ListDrivers:input_type -> deliveryplanner.ListDriversRequest
+	9,  // 17: deliveryplanner.DriverService.UpdateDriver:input_type -> deliveryplanner.UpdateDriverRequest
+	11, // 18: deliveryplanner.DriverService.DeleteDriver:input_type -> deliveryplanner.DeleteDriverRequest
+	13, // 19: deliveryplanner.DriverService.GetDriverRoutes:input_type -> deliveryplanner.GetDriverRoutesRequest
+	4,  // 20: deliveryplanner.DriverService.CreateDriver:output_type -> deliveryplanner.CreateDriverResponse
+	6,  // 21: deliveryplanner.DriverService.GetDriver:output_type -> deliveryplanner.GetDriverResponse
+	8,  // 22: deliveryplanner.DriverService.ListDrivers:output_type -> deliveryplanner.ListDriversResponse
+	10, // 23: deliveryplanner.DriverService.UpdateDriver:output_type -> deliveryplanner.UpdateDriverResponse
+	12, // 24: deliveryplanner.DriverService.DeleteDriver:output_type -> deliveryplanner.DeleteDriverResponse
+	14, // 25: deliveryplanner.DriverService.GetDriverRoutes:output_type -> deliveryplanner.GetDriverRoutesResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_driver_proto_init() }
@@ -946,7 +1143,7 @@ func file_proto_driver_proto_init() {
 	file_proto_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proto_driver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Driver); i {
+			switch v := v.(*VehicleCapacity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -958,7 +1155,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDriverRequest); i {
+			switch v := v.(*Driver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -970,7 +1167,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDriverResponse); i {
+			switch v := v.(*CreateDriverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -982,7 +1179,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDriverRequest); i {
+			switch v := v.(*CreateDriverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -994,7 +1191,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDriverResponse); i {
+			switch v := v.(*GetDriverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1006,7 +1203,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDriversRequest); i {
+			switch v := v.(*GetDriverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1018,7 +1215,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDriversResponse); i {
+			switch v := v.(*ListDriversRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1030,7 +1227,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDriverRequest); i {
+			switch v := v.(*ListDriversResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1042,7 +1239,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDriverResponse); i {
+			switch v := v.(*UpdateDriverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1054,7 +1251,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDriverRequest); i {
+			switch v := v.(*UpdateDriverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1066,7 +1263,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDriverResponse); i {
+			switch v := v.(*DeleteDriverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,7 +1275,7 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDriverRoutesRequest); i {
+			switch v := v.(*DeleteDriverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,6 +1287,18 @@ func file_proto_driver_proto_init() {
 			}
 		}
 		file_proto_driver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDriverRoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_driver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDriverRoutesResponse); i {
 			case 0:
 				return &v.state
@@ -1102,13 +1311,14 @@ func file_proto_driver_proto_init() {
 			}
 		}
 	}
+	file_proto_driver_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_driver_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,14 @@ const (
 type PackageStatus int32
 
 const (
-	PackageStatus_PACKAGE_STATUS_UNSPECIFIED PackageStatus = 0
-	PackageStatus_PACKAGE_STATUS_PENDING     PackageStatus = 1
-	PackageStatus_PACKAGE_STATUS_ON_ROUTE    PackageStatus = 2
-	PackageStatus_PACKAGE_STATUS_DELIVERED   PackageStatus = 3
-	PackageStatus_PACKAGE_STATUS_FAILED      PackageStatus = 4
+	PackageStatus_PACKAGE_STATUS_UNSPECIFIED      PackageStatus = 0
+	PackageStatus_PACKAGE_STATUS_PENDING          PackageStatus = 1
+	PackageStatus_PACKAGE_STATUS_OUT_FOR_DELIVERY PackageStatus = 2
+	PackageStatus_PACKAGE_STATUS_DELIVERED        PackageStatus = 3
+	PackageStatus_PACKAGE_STATUS_FAILED           PackageStatus = 4
+	PackageStatus_PACKAGE_STATUS_ASSIGNED         PackageStatus = 5
+	PackageStatus_PACKAGE_STATUS_RETURNED         PackageStatus = 6
+	PackageStatus_PACKAGE_STATUS_CANCELLED        PackageStatus = 7
 )
 
 // Enum value maps for PackageStatus.
@@ -37,16 +40,22 @@ var (
 	PackageStatus_name = map[int32]string{
 		0: "PACKAGE_STATUS_UNSPECIFIED",
 		1: "PACKAGE_STATUS_PENDING",
-		2: "PACKAGE_STATUS_ON_ROUTE",
+		2: "PACKAGE_STATUS_OUT_FOR_DELIVERY",
 		3: "PACKAGE_STATUS_DELIVERED",
 		4: "PACKAGE_STATUS_FAILED",
+		5: "PACKAGE_STATUS_ASSIGNED",
+		6: "PACKAGE_STATUS_RETURNED",
+		7: "PACKAGE_STATUS_CANCELLED",
 	}
 	PackageStatus_value = map[string]int32{
-		"PACKAGE_STATUS_UNSPECIFIED": 0,
-		"PACKAGE_STATUS_PENDING":     1,
-		"PACKAGE_STATUS_ON_ROUTE":    2,
-		"PACKAGE_STATUS_DELIVERED":   3,
-		"PACKAGE_STATUS_FAILED":      4,
+		"PACKAGE_STATUS_UNSPECIFIED":      0,
+		"PACKAGE_STATUS_PENDING":          1,
+		"PACKAGE_STATUS_OUT_FOR_DELIVERY": 2,
+		"PACKAGE_STATUS_DELIVERED":        3,
+		"PACKAGE_STATUS_FAILED":           4,
+		"PACKAGE_STATUS_ASSIGNED":         5,
+		"PACKAGE_STATUS_RETURNED":         6,
+		"PACKAGE_STATUS_CANCELLED":        7,
 	}
 )
 
@@ -77,6 +86,68 @@ func (PackageStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_package_proto_rawDescGZIP(), []int{0}
 }
 
+// DeliveryFailureReason explains why a delivery attempt did not succeed
+type DeliveryFailureReason int32
+
+const (
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_UNSPECIFIED       DeliveryFailureReason = 0
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_CUSTOMER_NOT_HOME DeliveryFailureReason = 1
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_ADDRESS_NOT_FOUND DeliveryFailureReason = 2
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_REFUSED           DeliveryFailureReason = 3
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_DAMAGED           DeliveryFailureReason = 4
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_ACCESS_RESTRICTED DeliveryFailureReason = 5
+	DeliveryFailureReason_DELIVERY_FAILURE_REASON_OTHER             DeliveryFailureReason = 6
+)
+
+// Enum value maps for DeliveryFailureReason.
+var (
+	DeliveryFailureReason_name = map[int32]string{
+		0: "DELIVERY_FAILURE_REASON_UNSPECIFIED",
+		1: "DELIVERY_FAILURE_REASON_CUSTOMER_NOT_HOME",
+		2: "DELIVERY_FAILURE_REASON_ADDRESS_NOT_FOUND",
+		3: "DELIVERY_FAILURE_REASON_REFUSED",
+		4: "DELIVERY_FAILURE_REASON_DAMAGED",
+		5: "DELIVERY_FAILURE_REASON_ACCESS_RESTRICTED",
+		6: "DELIVERY_FAILURE_REASON_OTHER",
+	}
+	DeliveryFailureReason_value = map[string]int32{
+		"DELIVERY_FAILURE_REASON_UNSPECIFIED":       0,
+		"DELIVERY_FAILURE_REASON_CUSTOMER_NOT_HOME": 1,
+		"DELIVERY_FAILURE_REASON_ADDRESS_NOT_FOUND": 2,
+		"DELIVERY_FAILURE_REASON_REFUSED":           3,
+		"DELIVERY_FAILURE_REASON_DAMAGED":           4,
+		"DELIVERY_FAILURE_REASON_ACCESS_RESTRICTED": 5,
+		"DELIVERY_FAILURE_REASON_OTHER":             6,
+	}
+)
+
+func (x DeliveryFailureReason) Enum() *DeliveryFailureReason {
+	p := new(DeliveryFailureReason)
+	*p = x
+	return p
+}
+
+func (x DeliveryFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_package_proto_enumTypes[1].Descriptor()
+}
+
+func (DeliveryFailureReason) Type() protoreflect.EnumType {
+	return &file_proto_package_proto_enumTypes[1]
+}
+
+func (x DeliveryFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryFailureReason.Descriptor instead.
+func (DeliveryFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{1}
+}
+
 // Location represents a geographical location
 type Location struct {
 	state         protoimpl.MessageState
@@ -133,29 +204,301 @@ func (x *Location) GetLongitude() float64 {
 	return 0
 }
 
+// TimeWindow represents the period in which a customer accepts a delivery
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Earliest *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=earliest,proto3" json:"earliest,omitempty"`
+	Latest   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=latest,proto3" json:"latest,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeWindow) GetEarliest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Earliest
+	}
+	return nil
+}
+
+func (x *TimeWindow) GetLatest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+// DeliveryFailure describes the most recent failed delivery attempt of a package
+type DeliveryFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason   DeliveryFailureReason  `protobuf:"varint,1,opt,name=reason,proto3,enum=deliveryplanner.DeliveryFailureReason" json:"reason,omitempty"`
+	Note     string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Attempt  int32                  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	FailedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+}
+
+func (x *DeliveryFailure) Reset() {
+	*x = DeliveryFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryFailure) ProtoMessage() {}
+
+func (x *DeliveryFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryFailure.ProtoReflect.Descriptor instead.
+func (*DeliveryFailure) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliveryFailure) GetReason() DeliveryFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DeliveryFailureReason_DELIVERY_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *DeliveryFailure) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *DeliveryFailure) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *DeliveryFailure) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+// Attachment represents a binary file captured at delivery time
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ProofOfDelivery represents the evidence captured by the driver when handing over a package
+type ProofOfDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipientName string                 `protobuf:"bytes,1,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	Location      *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Signature     *Attachment            `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Photo         *Attachment            `protobuf:"bytes,4,opt,name=photo,proto3" json:"photo,omitempty"`
+	CapturedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+}
+
+func (x *ProofOfDelivery) Reset() {
+	*x = ProofOfDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofOfDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofOfDelivery) ProtoMessage() {}
+
+func (x *ProofOfDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofOfDelivery.ProtoReflect.Descriptor instead.
+func (*ProofOfDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProofOfDelivery) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *ProofOfDelivery) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ProofOfDelivery) GetSignature() *Attachment {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ProofOfDelivery) GetPhoto() *Attachment {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *ProofOfDelivery) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
 // Package represents a delivery package
 type Package struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TrackingNumber    string                 `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	CustomerName      string                 `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerAddress   string                 `protobuf:"bytes,4,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
-	CustomerPhone     string                 `protobuf:"bytes,5,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
-	WeightKg          float64                `protobuf:"fixed64,6,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
-	VolumeM3          float64                `protobuf:"fixed64,7,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
-	Delivered         bool                   `protobuf:"varint,8,opt,name=delivered,proto3" json:"delivered,omitempty"`
-	DeliveryTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivery_timestamp,json=deliveryTimestamp,proto3" json:"delivery_timestamp,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrackingNumber     string                 `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	CustomerName       string                 `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerAddress    string                 `protobuf:"bytes,4,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
+	CustomerPhone      string                 `protobuf:"bytes,5,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	WeightKg           float64                `protobuf:"fixed64,6,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	VolumeM3           float64                `protobuf:"fixed64,7,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
+	Delivered          bool                   `protobuf:"varint,8,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	DeliveryTimestamp  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivery_timestamp,json=deliveryTimestamp,proto3" json:"delivery_timestamp,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Location           *Location              `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	DeliveryWindow     *TimeWindow            `protobuf:"bytes,13,opt,name=delivery_window,json=deliveryWindow,proto3" json:"delivery_window,omitempty"`
+	ServiceDurationMin int32                  `protobuf:"varint,14,opt,name=service_duration_min,json=serviceDurationMin,proto3" json:"service_duration_min,omitempty"`
+	Status             PackageStatus          `protobuf:"varint,15,opt,name=status,proto3,enum=deliveryplanner.PackageStatus" json:"status,omitempty"`
+	FailedAttempts     int32                  `protobuf:"varint,16,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	LastFailure        *DeliveryFailure       `protobuf:"bytes,17,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	// Number of changes stored for the package; pass it back in updates to detect concurrent edits
+	Version int64 `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Package) Reset() {
 	*x = Package{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[1]
+		mi := &file_proto_package_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -168,7 +511,7 @@ func (x *Package) String() string {
 func (*Package) ProtoMessage() {}
 
 func (x *Package) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[1]
+	mi := &file_proto_package_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +524,7 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package.ProtoReflect.Descriptor instead.
 func (*Package) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{1}
+	return file_proto_package_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Package) GetId() string {
@@ -261,24 +604,196 @@ func (x *Package) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Package) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Package) GetDeliveryWindow() *TimeWindow {
+	if x != nil {
+		return x.DeliveryWindow
+	}
+	return nil
+}
+
+func (x *Package) GetServiceDurationMin() int32 {
+	if x != nil {
+		return x.ServiceDurationMin
+	}
+	return 0
+}
+
+func (x *Package) GetStatus() PackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PackageStatus_PACKAGE_STATUS_UNSPECIFIED
+}
+
+func (x *Package) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *Package) GetLastFailure() *DeliveryFailure {
+	if x != nil {
+		return x.LastFailure
+	}
+	return nil
+}
+
+func (x *Package) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// PackageEvent represents an entry in a package's tracking history
+type PackageEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PackageId  string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Type       string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Actor      string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	FromStatus PackageStatus          `protobuf:"varint,5,opt,name=from_status,json=fromStatus,proto3,enum=deliveryplanner.PackageStatus" json:"from_status,omitempty"`
+	ToStatus   PackageStatus          `protobuf:"varint,6,opt,name=to_status,json=toStatus,proto3,enum=deliveryplanner.PackageStatus" json:"to_status,omitempty"`
+	RouteId    string                 `protobuf:"bytes,7,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Location   *Location              `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Note       string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *PackageEvent) Reset() {
+	*x = PackageEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageEvent) ProtoMessage() {}
+
+func (x *PackageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageEvent.ProtoReflect.Descriptor instead.
+func (*PackageEvent) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PackageEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetFromStatus() PackageStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return PackageStatus_PACKAGE_STATUS_UNSPECIFIED
+}
+
+func (x *PackageEvent) GetToStatus() PackageStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return PackageStatus_PACKAGE_STATUS_UNSPECIFIED
+}
+
+func (x *PackageEvent) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PackageEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 // CreatePackageRequest represents the request to create a package
 type CreatePackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TrackingNumber  string  `protobuf:"bytes,1,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	CustomerName    string  `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerAddress string  `protobuf:"bytes,3,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
-	CustomerPhone   string  `protobuf:"bytes,4,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
-	WeightKg        float64 `protobuf:"fixed64,5,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
-	VolumeM3        float64 `protobuf:"fixed64,6,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
+	TrackingNumber     string      `protobuf:"bytes,1,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	CustomerName       string      `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerAddress    string      `protobuf:"bytes,3,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
+	CustomerPhone      string      `protobuf:"bytes,4,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	WeightKg           float64     `protobuf:"fixed64,5,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	VolumeM3           float64     `protobuf:"fixed64,6,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
+	Location           *Location   `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	DeliveryWindow     *TimeWindow `protobuf:"bytes,8,opt,name=delivery_window,json=deliveryWindow,proto3" json:"delivery_window,omitempty"`
+	ServiceDurationMin int32       `protobuf:"varint,9,opt,name=service_duration_min,json=serviceDurationMin,proto3" json:"service_duration_min,omitempty"`
 }
 
 func (x *CreatePackageRequest) Reset() {
 	*x = CreatePackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[2]
+		mi := &file_proto_package_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +806,7 @@ func (x *CreatePackageRequest) String() string {
 func (*CreatePackageRequest) ProtoMessage() {}
 
 func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[2]
+	mi := &file_proto_package_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +819,7 @@ func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
 func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{2}
+	return file_proto_package_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreatePackageRequest) GetTrackingNumber() string {
@@ -349,6 +864,27 @@ func (x *CreatePackageRequest) GetVolumeM3() float64 {
 	return 0
 }
 
+func (x *CreatePackageRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreatePackageRequest) GetDeliveryWindow() *TimeWindow {
+	if x != nil {
+		return x.DeliveryWindow
+	}
+	return nil
+}
+
+func (x *CreatePackageRequest) GetServiceDurationMin() int32 {
+	if x != nil {
+		return x.ServiceDurationMin
+	}
+	return 0
+}
+
 // CreatePackageResponse represents the response after creating a package
 type CreatePackageResponse struct {
 	state         protoimpl.MessageState
@@ -361,7 +897,7 @@ type CreatePackageResponse struct {
 func (x *CreatePackageResponse) Reset() {
 	*x = CreatePackageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[3]
+		mi := &file_proto_package_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -374,7 +910,7 @@ func (x *CreatePackageResponse) String() string {
 func (*CreatePackageResponse) ProtoMessage() {}
 
 func (x *CreatePackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[3]
+	mi := &file_proto_package_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +923,7 @@ func (x *CreatePackageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePackageResponse.ProtoReflect.Descriptor instead.
 func (*CreatePackageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{3}
+	return file_proto_package_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreatePackageResponse) GetPackage() *Package {
@@ -409,7 +945,7 @@ type GetPackageRequest struct {
 func (x *GetPackageRequest) Reset() {
 	*x = GetPackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[4]
+		mi := &file_proto_package_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +958,7 @@ func (x *GetPackageRequest) String() string {
 func (*GetPackageRequest) ProtoMessage() {}
 
 func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[4]
+	mi := &file_proto_package_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +971,7 @@ func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPackageRequest.ProtoReflect.Descriptor instead.
 func (*GetPackageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{4}
+	return file_proto_package_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPackageRequest) GetId() string {
@@ -457,7 +993,7 @@ type GetPackageResponse struct {
 func (x *GetPackageResponse) Reset() {
 	*x = GetPackageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[5]
+		mi := &file_proto_package_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -470,7 +1006,7 @@ func (x *GetPackageResponse) String() string {
 func (*GetPackageResponse) ProtoMessage() {}
 
 func (x *GetPackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[5]
+	mi := &file_proto_package_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +1019,7 @@ func (x *GetPackageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPackageResponse.ProtoReflect.Descriptor instead.
 func (*GetPackageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{5}
+	return file_proto_package_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPackageResponse) GetPackage() *Package {
@@ -505,7 +1041,7 @@ type GetPackageByTrackingNumberRequest struct {
 func (x *GetPackageByTrackingNumberRequest) Reset() {
 	*x = GetPackageByTrackingNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[6]
+		mi := &file_proto_package_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +1054,7 @@ func (x *GetPackageByTrackingNumberRequest) String() string {
 func (*GetPackageByTrackingNumberRequest) ProtoMessage() {}
 
 func (x *GetPackageByTrackingNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[6]
+	mi := &file_proto_package_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +1067,7 @@ func (x *GetPackageByTrackingNumberRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPackageByTrackingNumberRequest.ProtoReflect.Descriptor instead.
 func (*GetPackageByTrackingNumberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{6}
+	return file_proto_package_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetPackageByTrackingNumberRequest) GetTrackingNumber() string {
@@ -553,7 +1089,7 @@ type GetPackageByTrackingNumberResponse struct {
 func (x *GetPackageByTrackingNumberResponse) Reset() {
 	*x = GetPackageByTrackingNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[7]
+		mi := &file_proto_package_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +1102,7 @@ func (x *GetPackageByTrackingNumberResponse) String() string {
 func (*GetPackageByTrackingNumberResponse) ProtoMessage() {}
 
 func (x *GetPackageByTrackingNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[7]
+	mi := &file_proto_package_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +1115,7 @@ func (x *GetPackageByTrackingNumberResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPackageByTrackingNumberResponse.ProtoReflect.Descriptor instead.
 func (*GetPackageByTrackingNumberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{7}
+	return file_proto_package_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPackageByTrackingNumberResponse) GetPackage() *Package {
@@ -594,12 +1130,26 @@ type ListPackagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of packages to return; defaults to 50, capped at 200
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Cursor returned as next_page_token by a previous call; empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// List the newest packages first
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Only list packages in this status
+	Status PackageStatus `protobuf:"varint,4,opt,name=status,proto3,enum=deliveryplanner.PackageStatus" json:"status,omitempty"`
+	// Only list packages whose tracking number starts with this prefix
+	TrackingPrefix string `protobuf:"bytes,5,opt,name=tracking_prefix,json=trackingPrefix,proto3" json:"tracking_prefix,omitempty"`
+	// Only list packages created within this range; either bound may be left unset
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
 }
 
 func (x *ListPackagesRequest) Reset() {
 	*x = ListPackagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[8]
+		mi := &file_proto_package_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -612,7 +1162,7 @@ func (x *ListPackagesRequest) String() string {
 func (*ListPackagesRequest) ProtoMessage() {}
 
 func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[8]
+	mi := &file_proto_package_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1175,56 @@ func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPackagesRequest.ProtoReflect.Descriptor instead.
 func (*ListPackagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{8}
+	return file_proto_package_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPackagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPackagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPackagesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListPackagesRequest) GetStatus() PackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PackageStatus_PACKAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ListPackagesRequest) GetTrackingPrefix() string {
+	if x != nil {
+		return x.TrackingPrefix
+	}
+	return ""
+}
+
+func (x *ListPackagesRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPackagesRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
 }
 
 // ListPackagesResponse represents the response after listing packages
@@ -635,12 +1234,14 @@ type ListPackagesResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Packages []*Package `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	// Cursor for the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListPackagesResponse) Reset() {
 	*x = ListPackagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[9]
+		mi := &file_proto_package_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +1254,7 @@ func (x *ListPackagesResponse) String() string {
 func (*ListPackagesResponse) ProtoMessage() {}
 
 func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[9]
+	mi := &file_proto_package_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +1267,7 @@ func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
 func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{9}
+	return file_proto_package_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListPackagesResponse) GetPackages() []*Package {
@@ -676,25 +1277,37 @@ func (x *ListPackagesResponse) GetPackages() []*Package {
 	return nil
 }
 
+func (x *ListPackagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // UpdatePackageRequest represents the request to update a package
 type UpdatePackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TrackingNumber  string  `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	CustomerName    string  `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerAddress string  `protobuf:"bytes,4,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
-	CustomerPhone   string  `protobuf:"bytes,5,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
-	WeightKg        float64 `protobuf:"fixed64,6,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
-	VolumeM3        float64 `protobuf:"fixed64,7,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
+	Id                 string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrackingNumber     string      `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	CustomerName       string      `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerAddress    string      `protobuf:"bytes,4,opt,name=customer_address,json=customerAddress,proto3" json:"customer_address,omitempty"`
+	CustomerPhone      string      `protobuf:"bytes,5,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	WeightKg           float64     `protobuf:"fixed64,6,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	VolumeM3           float64     `protobuf:"fixed64,7,opt,name=volume_m3,json=volumeM3,proto3" json:"volume_m3,omitempty"`
+	Location           *Location   `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	DeliveryWindow     *TimeWindow `protobuf:"bytes,9,opt,name=delivery_window,json=deliveryWindow,proto3" json:"delivery_window,omitempty"`
+	ServiceDurationMin int32       `protobuf:"varint,10,opt,name=service_duration_min,json=serviceDurationMin,proto3" json:"service_duration_min,omitempty"`
+	// Version the update is based on; it fails if the package changed since. 0 skips the check
+	Version int64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdatePackageRequest) Reset() {
 	*x = UpdatePackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[10]
+		mi := &file_proto_package_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +1320,7 @@ func (x *UpdatePackageRequest) String() string {
 func (*UpdatePackageRequest) ProtoMessage() {}
 
 func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[10]
+	mi := &file_proto_package_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +1333,7 @@ func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePackageRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePackageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{10}
+	return file_proto_package_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdatePackageRequest) GetId() string {
@@ -772,6 +1385,34 @@ func (x *UpdatePackageRequest) GetVolumeM3() float64 {
 	return 0
 }
 
+func (x *UpdatePackageRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdatePackageRequest) GetDeliveryWindow() *TimeWindow {
+	if x != nil {
+		return x.DeliveryWindow
+	}
+	return nil
+}
+
+func (x *UpdatePackageRequest) GetServiceDurationMin() int32 {
+	if x != nil {
+		return x.ServiceDurationMin
+	}
+	return 0
+}
+
+func (x *UpdatePackageRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // UpdatePackageResponse represents the response after updating a package
 type UpdatePackageResponse struct {
 	state         protoimpl.MessageState
@@ -784,7 +1425,7 @@ type UpdatePackageResponse struct {
 func (x *UpdatePackageResponse) Reset() {
 	*x = UpdatePackageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[11]
+		mi := &file_proto_package_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -797,7 +1438,7 @@ func (x *UpdatePackageResponse) String() string {
 func (*UpdatePackageResponse) ProtoMessage() {}
 
 func (x *UpdatePackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[11]
+	mi := &file_proto_package_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1451,7 @@ func (x *UpdatePackageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePackageResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePackageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{11}
+	return file_proto_package_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdatePackageResponse) GetPackage() *Package {
@@ -826,14 +1467,16 @@ type UpdatePackageStatusRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status PackageStatus `protobuf:"varint,2,opt,name=status,proto3,enum=deliveryplanner.PackageStatus" json:"status,omitempty"`
+	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   PackageStatus `protobuf:"varint,2,opt,name=status,proto3,enum=deliveryplanner.PackageStatus" json:"status,omitempty"`
+	Location *Location     `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Note     string        `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
 }
 
 func (x *UpdatePackageStatusRequest) Reset() {
 	*x = UpdatePackageStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[12]
+		mi := &file_proto_package_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +1489,7 @@ func (x *UpdatePackageStatusRequest) String() string {
 func (*UpdatePackageStatusRequest) ProtoMessage() {}
 
 func (x *UpdatePackageStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[12]
+	mi := &file_proto_package_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1502,7 @@ func (x *UpdatePackageStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePackageStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePackageStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{12}
+	return file_proto_package_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdatePackageStatusRequest) GetId() string {
@@ -876,6 +1519,20 @@ func (x *UpdatePackageStatusRequest) GetStatus() PackageStatus {
 	return PackageStatus_PACKAGE_STATUS_UNSPECIFIED
 }
 
+func (x *UpdatePackageStatusRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdatePackageStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 // UpdatePackageStatusResponse represents the response after updating a package's status
 type UpdatePackageStatusResponse struct {
 	state         protoimpl.MessageState
@@ -888,7 +1545,7 @@ type UpdatePackageStatusResponse struct {
 func (x *UpdatePackageStatusResponse) Reset() {
 	*x = UpdatePackageStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[13]
+		mi := &file_proto_package_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1558,7 @@ func (x *UpdatePackageStatusResponse) String() string {
 func (*UpdatePackageStatusResponse) ProtoMessage() {}
 
 func (x *UpdatePackageStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[13]
+	mi := &file_proto_package_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1571,7 @@ func (x *UpdatePackageStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePackageStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePackageStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{13}
+	return file_proto_package_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdatePackageStatusResponse) GetPackage() *Package {
@@ -930,13 +1587,14 @@ type MarkPackageAsDeliveredRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id    string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proof *ProofOfDelivery `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (x *MarkPackageAsDeliveredRequest) Reset() {
 	*x = MarkPackageAsDeliveredRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[14]
+		mi := &file_proto_package_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -949,7 +1607,7 @@ func (x *MarkPackageAsDeliveredRequest) String() string {
 func (*MarkPackageAsDeliveredRequest) ProtoMessage() {}
 
 func (x *MarkPackageAsDeliveredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[14]
+	mi := &file_proto_package_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1620,7 @@ func (x *MarkPackageAsDeliveredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkPackageAsDeliveredRequest.ProtoReflect.Descriptor instead.
 func (*MarkPackageAsDeliveredRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{14}
+	return file_proto_package_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MarkPackageAsDeliveredRequest) GetId() string {
@@ -972,6 +1630,13 @@ func (x *MarkPackageAsDeliveredRequest) GetId() string {
 	return ""
 }
 
+func (x *MarkPackageAsDeliveredRequest) GetProof() *ProofOfDelivery {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 // MarkPackageAsDeliveredResponse represents the response after marking a package as delivered
 type MarkPackageAsDeliveredResponse struct {
 	state         protoimpl.MessageState
@@ -984,7 +1649,7 @@ type MarkPackageAsDeliveredResponse struct {
 func (x *MarkPackageAsDeliveredResponse) Reset() {
 	*x = MarkPackageAsDeliveredResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[15]
+		mi := &file_proto_package_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1662,7 @@ func (x *MarkPackageAsDeliveredResponse) String() string {
 func (*MarkPackageAsDeliveredResponse) ProtoMessage() {}
 
 func (x *MarkPackageAsDeliveredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[15]
+	mi := &file_proto_package_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1675,7 @@ func (x *MarkPackageAsDeliveredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkPackageAsDeliveredResponse.ProtoReflect.Descriptor instead.
 func (*MarkPackageAsDeliveredResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{15}
+	return file_proto_package_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MarkPackageAsDeliveredResponse) GetPackage() *Package {
@@ -1032,7 +1697,7 @@ type DeletePackageRequest struct {
 func (x *DeletePackageRequest) Reset() {
 	*x = DeletePackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[16]
+		mi := &file_proto_package_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1710,7 @@ func (x *DeletePackageRequest) String() string {
 func (*DeletePackageRequest) ProtoMessage() {}
 
 func (x *DeletePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[16]
+	mi := &file_proto_package_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1723,7 @@ func (x *DeletePackageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePackageRequest.ProtoReflect.Descriptor instead.
 func (*DeletePackageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{16}
+	return file_proto_package_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeletePackageRequest) GetId() string {
@@ -1075,23 +1740,207 @@ type DeletePackageResponse struct {
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeletePackageResponse) Reset() {
-	*x = DeletePackageResponse{}
+func (x *DeletePackageResponse) Reset() {
+	*x = DeletePackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageResponse) ProtoMessage() {}
+
+func (x *DeletePackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageResponse.ProtoReflect.Descriptor instead.
+func (*DeletePackageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{22}
+}
+
+// AssignToRouteRequest represents the request to assign a package to a route
+type AssignToRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	RouteId   string `protobuf:"bytes,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+}
+
+func (x *AssignToRouteRequest) Reset() {
+	*x = AssignToRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignToRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignToRouteRequest) ProtoMessage() {}
+
+func (x *AssignToRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignToRouteRequest.ProtoReflect.Descriptor instead.
+func (*AssignToRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssignToRouteRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *AssignToRouteRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+// AssignToRouteResponse represents the response after assigning a package to a route
+type AssignToRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AssignToRouteResponse) Reset() {
+	*x = AssignToRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignToRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignToRouteResponse) ProtoMessage() {}
+
+func (x *AssignToRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignToRouteResponse.ProtoReflect.Descriptor instead.
+func (*AssignToRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{24}
+}
+
+// GetPackagesByRouteRequest represents the request to get packages by route
+type GetPackagesByRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId string `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+}
+
+func (x *GetPackagesByRouteRequest) Reset() {
+	*x = GetPackagesByRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_package_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPackagesByRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackagesByRouteRequest) ProtoMessage() {}
+
+func (x *GetPackagesByRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackagesByRouteRequest.ProtoReflect.Descriptor instead.
+func (*GetPackagesByRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPackagesByRouteRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+// GetPackagesByRouteResponse represents the response after getting packages by route
+type GetPackagesByRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packages []*Package `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+}
+
+func (x *GetPackagesByRouteResponse) Reset() {
+	*x = GetPackagesByRouteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[17]
+		mi := &file_proto_package_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePackageResponse) String() string {
+func (x *GetPackagesByRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePackageResponse) ProtoMessage() {}
+func (*GetPackagesByRouteResponse) ProtoMessage() {}
 
-func (x *DeletePackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[17]
+func (x *GetPackagesByRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,38 +1951,44 @@ func (x *DeletePackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePackageResponse.ProtoReflect.Descriptor instead.
-func (*DeletePackageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetPackagesByRouteResponse.ProtoReflect.Descriptor instead.
+func (*GetPackagesByRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{26}
 }
 
-// AssignToRouteRequest represents the request to assign a package to a route
-type AssignToRouteRequest struct {
+func (x *GetPackagesByRouteResponse) GetPackages() []*Package {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+// GetPackageHistoryRequest represents the request to get a package's tracking history
+type GetPackageHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	RouteId   string `protobuf:"bytes,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AssignToRouteRequest) Reset() {
-	*x = AssignToRouteRequest{}
+func (x *GetPackageHistoryRequest) Reset() {
+	*x = GetPackageHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[18]
+		mi := &file_proto_package_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignToRouteRequest) String() string {
+func (x *GetPackageHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignToRouteRequest) ProtoMessage() {}
+func (*GetPackageHistoryRequest) ProtoMessage() {}
 
-func (x *AssignToRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[18]
+func (x *GetPackageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,49 +1999,44 @@ func (x *AssignToRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignToRouteRequest.ProtoReflect.Descriptor instead.
-func (*AssignToRouteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *AssignToRouteRequest) GetPackageId() string {
-	if x != nil {
-		return x.PackageId
-	}
-	return ""
+// Deprecated: Use GetPackageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AssignToRouteRequest) GetRouteId() string {
+func (x *GetPackageHistoryRequest) GetId() string {
 	if x != nil {
-		return x.RouteId
+		return x.Id
 	}
 	return ""
 }
 
-// AssignToRouteResponse represents the response after assigning a package to a route
-type AssignToRouteResponse struct {
+// GetPackageHistoryResponse represents the response containing a package's tracking history
+type GetPackageHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Events []*PackageEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AssignToRouteResponse) Reset() {
-	*x = AssignToRouteResponse{}
+func (x *GetPackageHistoryResponse) Reset() {
+	*x = GetPackageHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[19]
+		mi := &file_proto_package_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignToRouteResponse) String() string {
+func (x *GetPackageHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignToRouteResponse) ProtoMessage() {}
+func (*GetPackageHistoryResponse) ProtoMessage() {}
 
-func (x *AssignToRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[19]
+func (x *GetPackageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,37 +2047,44 @@ func (x *AssignToRouteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignToRouteResponse.ProtoReflect.Descriptor instead.
-func (*AssignToRouteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetPackageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPackageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{28}
 }
 
-// GetPackagesByRouteRequest represents the request to get packages by route
-type GetPackagesByRouteRequest struct {
+func (x *GetPackageHistoryResponse) GetEvents() []*PackageEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// GetProofOfDeliveryRequest represents the request to get a package's proof of delivery
+type GetProofOfDeliveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RouteId string `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetPackagesByRouteRequest) Reset() {
-	*x = GetPackagesByRouteRequest{}
+func (x *GetProofOfDeliveryRequest) Reset() {
+	*x = GetProofOfDeliveryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[20]
+		mi := &file_proto_package_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPackagesByRouteRequest) String() string {
+func (x *GetProofOfDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackagesByRouteRequest) ProtoMessage() {}
+func (*GetProofOfDeliveryRequest) ProtoMessage() {}
 
-func (x *GetPackagesByRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[20]
+func (x *GetProofOfDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,44 +2095,44 @@ func (x *GetPackagesByRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackagesByRouteRequest.ProtoReflect.Descriptor instead.
-func (*GetPackagesByRouteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetProofOfDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*GetProofOfDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetPackagesByRouteRequest) GetRouteId() string {
+func (x *GetProofOfDeliveryRequest) GetId() string {
 	if x != nil {
-		return x.RouteId
+		return x.Id
 	}
 	return ""
 }
 
-// GetPackagesByRouteResponse represents the response after getting packages by route
-type GetPackagesByRouteResponse struct {
+// GetProofOfDeliveryResponse represents the response containing a package's proof of delivery
+type GetProofOfDeliveryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packages []*Package `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	Proof *ProofOfDelivery `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *GetPackagesByRouteResponse) Reset() {
-	*x = GetPackagesByRouteResponse{}
+func (x *GetProofOfDeliveryResponse) Reset() {
+	*x = GetProofOfDeliveryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_package_proto_msgTypes[21]
+		mi := &file_proto_package_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPackagesByRouteResponse) String() string {
+func (x *GetProofOfDeliveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackagesByRouteResponse) ProtoMessage() {}
+func (*GetProofOfDeliveryResponse) ProtoMessage() {}
 
-func (x *GetPackagesByRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_package_proto_msgTypes[21]
+func (x *GetProofOfDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_package_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,14 +2143,14 @@ func (x *GetPackagesByRouteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackagesByRouteResponse.ProtoReflect.Descriptor instead.
-func (*GetPackagesByRouteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_package_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetProofOfDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*GetProofOfDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_package_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetPackagesByRouteResponse) GetPackages() []*Package {
+func (x *GetProofOfDeliveryResponse) GetProof() *ProofOfDelivery {
 	if x != nil {
-		return x.Packages
+		return x.Proof
 	}
 	return nil
 }
@@ -1309,223 +2166,421 @@ var file_proto_package_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xd2, 0x03,
-	0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x78, 0x0a,
+	0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x36, 0x0a, 0x08, 0x65,
+	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x61, 0x72, 0x6c, 0x69,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x43, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xc1, 0x06, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x33, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x44, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x03, 0x0a, 0x0c, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x9f, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
 	0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x6d, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74,
 	0x6f, 0x6d, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x65,
+	0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x65,
 	0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x5f, 0x6d, 0x33, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x4d, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
-	0x64, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x5f, 0x6d, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x4d, 0x33, 0x22, 0x4b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x5f, 0x6d, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x4d, 0x33, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22,
+	0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x4c,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3d, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x74, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc9, 0x03, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6b,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b,
+	0x67, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x33, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x33, 0x12, 0x35,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x22, 0x4c, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
-	0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x58, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
 	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x80,
-	0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x6b, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x4b, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d,
-	0x33, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d,
-	0x33, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x64,
-	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x1d, 0x4d, 0x61, 0x72,
+	0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x22, 0x54, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x14, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
 	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x2f, 0x0a, 0x1d, 0x4d, 0x61, 0x72, 0x6b, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x26,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x50, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49,
-	0x64, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x49, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2a, 0xa1, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b,
-	0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b,
-	0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x10,
-	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbc, 0x08, 0x0a, 0x0e, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x2a, 0x81, 0x02, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x43,
+	0x4b, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xba, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x23, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x44, 0x45,
+	0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a,
+	0x1f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x2d, 0x0a, 0x29, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0x06, 0x32, 0x9b, 0x0a, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
 	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x64, 0x65,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65,
 	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
-	0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x32, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x60, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
-	0x64, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41,
-	0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41,
-	0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2a,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x63, 0x61, 0x6e, 0x6d, 0x2f, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x6f,
-	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x7b, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2e, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x41, 0x72, 0x63, 0x61, 0x6e, 0x6d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1540,72 +2595,113 @@ func file_proto_package_proto_rawDescGZIP() []byte {
 	return file_proto_package_proto_rawDescData
 }
 
-var file_proto_package_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_package_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_package_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_package_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_proto_package_proto_goTypes = []interface{}{
 	(PackageStatus)(0),                         // 0: deliveryplanner.PackageStatus
-	(*Location)(nil),                           // 1: deliveryplanner.Location
-	(*Package)(nil),                            // 2: deliveryplanner.Package
-	(*CreatePackageRequest)(nil),               // 3: deliveryplanner.CreatePackageRequest
-	(*CreatePackageResponse)(nil),              // 4: deliveryplanner.CreatePackageResponse
-	(*GetPackageRequest)(nil),                  // 5: deliveryplanner.GetPackageRequest
-	(*GetPackageResponse)(nil),                 // 6: deliveryplanner.GetPackageResponse
-	(*GetPackageByTrackingNumberRequest)(nil),  // 7: deliveryplanner.GetPackageByTrackingNumberRequest
-	(*GetPackageByTrackingNumberResponse)(nil), // 8: deliveryplanner.GetPackageByTrackingNumberResponse
-	(*ListPackagesRequest)(nil),                // 9: deliveryplanner.ListPackagesRequest
-	(*ListPackagesResponse)(nil),               // 10: deliveryplanner.ListPackagesResponse
-	(*UpdatePackageRequest)(nil),               // 11: deliveryplanner.UpdatePackageRequest
-	(*UpdatePackageResponse)(nil),              // 12: deliveryplanner.UpdatePackageResponse
-	(*UpdatePackageStatusRequest)(nil),         // 13: deliveryplanner.UpdatePackageStatusRequest
-	(*UpdatePackageStatusResponse)(nil),        // 14: deliveryplanner.UpdatePackageStatusResponse
-	(*MarkPackageAsDeliveredRequest)(nil),      // 15: deliveryplanner.MarkPackageAsDeliveredRequest
-	(*MarkPackageAsDeliveredResponse)(nil),     // 16: deliveryplanner.MarkPackageAsDeliveredResponse
-	(*DeletePackageRequest)(nil),               // 17: deliveryplanner.DeletePackageRequest
-	(*DeletePackageResponse)(nil),              // 18: deliveryplanner.DeletePackageResponse
-	(*AssignToRouteRequest)(nil),               // 19: deliveryplanner.AssignToRouteRequest
-	(*AssignToRouteResponse)(nil),              // 20: deliveryplanner.AssignToRouteResponse
-	(*GetPackagesByRouteRequest)(nil),          // 21: deliveryplanner.GetPackagesByRouteRequest
-	(*GetPackagesByRouteResponse)(nil),         // 22: deliveryplanner.GetPackagesByRouteResponse
-	(*timestamppb.Timestamp)(nil),              // 23: google.protobuf.Timestamp
+	(DeliveryFailureReason)(0),                 // 1: deliveryplanner.DeliveryFailureReason
+	(*Location)(nil),                           // 2: deliveryplanner.Location
+	(*TimeWindow)(nil),                         // 3: deliveryplanner.TimeWindow
+	(*DeliveryFailure)(nil),                    // 4: deliveryplanner.DeliveryFailure
+	(*Attachment)(nil),                         // 5: deliveryplanner.Attachment
+	(*ProofOfDelivery)(nil),                    // 6: deliveryplanner.ProofOfDelivery
+	(*Package)(nil),                            // 7: deliveryplanner.Package
+	(*PackageEvent)(nil),                       // 8: deliveryplanner.PackageEvent
+	(*CreatePackageRequest)(nil),               // 9: deliveryplanner.CreatePackageRequest
+	(*CreatePackageResponse)(nil),              // 10: deliveryplanner.CreatePackageResponse
+	(*GetPackageRequest)(nil),                  // 11: deliveryplanner.GetPackageRequest
+	(*GetPackageResponse)(nil),                 // 12: deliveryplanner.GetPackageResponse
+	(*GetPackageByTrackingNumberRequest)(nil),  // 13: deliveryplanner.GetPackageByTrackingNumberRequest
+	(*GetPackageByTrackingNumberResponse)(nil), // 14: deliveryplanner.GetPackageByTrackingNumberResponse
+	(*ListPackagesRequest)(nil),                // 15: deliveryplanner.ListPackagesRequest
+	(*ListPackagesResponse)(nil),               // 16: deliveryplanner.ListPackagesResponse
+	(*UpdatePackageRequest)(nil),               // 17: deliveryplanner.UpdatePackageRequest
+	(*UpdatePackageResponse)(nil),              // 18: deliveryplanner.UpdatePackageResponse
+	(*UpdatePackageStatusRequest)(nil),         // 19: deliveryplanner.UpdatePackageStatusRequest
+	(*UpdatePackageStatusResponse)(nil),        // 20: deliveryplanner.UpdatePackageStatusResponse
+	(*MarkPackageAsDeliveredRequest)(nil),      // 21: deliveryplanner.MarkPackageAsDeliveredRequest
+	(*MarkPackageAsDeliveredResponse)(nil),     // 22: deliveryplanner.MarkPackageAsDeliveredResponse
+	(*DeletePackageRequest)(nil),               // 23: deliveryplanner.DeletePackageRequest
+	(*DeletePackageResponse)(nil),              // 24: deliveryplanner.DeletePackageResponse
+	(*AssignToRouteRequest)(nil),               // 25: deliveryplanner.AssignToRouteRequest
+	(*AssignToRouteResponse)(nil),              // 26: deliveryplanner.AssignToRouteResponse
+	(*GetPackagesByRouteRequest)(nil),          // 27: deliveryplanner.GetPackagesByRouteRequest
+	(*GetPackagesByRouteResponse)(nil),         // 28: deliveryplanner.GetPackagesByRouteResponse
+	(*GetPackageHistoryRequest)(nil),           // 29: deliveryplanner.GetPackageHistoryRequest
+	(*GetPackageHistoryResponse)(nil),          // 30: deliveryplanner.GetPackageHistoryResponse
+	(*GetProofOfDeliveryRequest)(nil),          // 31: deliveryplanner.GetProofOfDeliveryRequest
+	(*GetProofOfDeliveryResponse)(nil),         // 32: deliveryplanner.GetProofOfDeliveryResponse
+	(*timestamppb.Timestamp)(nil),              // 33: google.protobuf.Timestamp
 }
 var file_proto_package_proto_depIdxs = []int32{
-	23, // 0: deliveryplanner.Package.delivery_timestamp:type_name -> google.protobuf.Timestamp
-	23, // 1: deliveryplanner.Package.created_at:type_name -> google.protobuf.Timestamp
-	23, // 2: deliveryplanner.Package.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: deliveryplanner.CreatePackageResponse.package:type_name -> deliveryplanner.Package
-	2,  // 4: deliveryplanner.GetPackageResponse.package:type_name -> deliveryplanner.Package
-	2,  // 5: deliveryplanner.GetPackageByTrackingNumberResponse.package:type_name -> deliveryplanner.Package
-	2,  // 6: deliveryplanner.ListPackagesResponse.packages:type_name -> deliveryplanner.Package
-	2,  // 7: deliveryplanner.UpdatePackageResponse.package:type_name -> deliveryplanner.Package
-	0,  // 8: deliveryplanner.UpdatePackageStatusRequest.status:type_name -> deliveryplanner.PackageStatus
-	2,  // 9: deliveryplanner.UpdatePackageStatusResponse.package:type_name -> deliveryplanner.Package
-	2,  // 10: deliveryplanner.MarkPackageAsDeliveredResponse.package:type_name -> deliveryplanner.Package
-	2,  // 11: deliveryplanner.GetPackagesByRouteResponse.packages:type_name -> deliveryplanner.Package
-	3,  // 12: deliveryplanner.PackageService.CreatePackage:input_type -> deliveryplanner.CreatePackageRequest
-	5,  // 13: deliveryplanner.PackageService.GetPackage:input_type -> deliveryplanner.GetPackageRequest
-	7,  // 14: deliveryplanner.PackageService.GetPackageByTrackingNumber:input_type -> deliveryplanner.GetPackageByTrackingNumberRequest
-	9,  // 15: deliveryplanner.PackageService.ListPackages:input_type -> deliveryplanner.ListPackagesRequest
-	11, // 16: deliveryplanner.PackageService.UpdatePackage:input_type -> deliveryplanner.UpdatePackageRequest
-	13, // 17: deliveryplanner.PackageService.UpdatePackageStatus:input_type -> deliveryplanner.UpdatePackageStatusRequest
-	15, // 18: deliveryplanner.PackageService.MarkPackageAsDelivered:input_type -> deliveryplanner.MarkPackageAsDeliveredRequest
-	17, // 19: deliveryplanner.PackageService.DeletePackage:input_type -> deliveryplanner.DeletePackageRequest
-	19, // 20: deliveryplanner.PackageService.AssignToRoute:input_type -> deliveryplanner.AssignToRouteRequest
-	21, // 21: deliveryplanner.PackageService.GetPackagesByRoute:input_type -> deliveryplanner.GetPackagesByRouteRequest
-	4,  // 22: deliveryplanner.PackageService.CreatePackage:output_type -> deliveryplanner.CreatePackageResponse
-	6,  // 23: deliveryplanner.PackageService.GetPackage:output_type -> deliveryplanner.GetPackageResponse
-	8,  // 24: deliveryplanner.PackageService.GetPackageByTrackingNumber:output_type -> deliveryplanner.GetPackageByTrackingNumberResponse
-	10, // 25: deliveryplanner.PackageService.ListPackages:output_type -> deliveryplanner.ListPackagesResponse
-	12, // 26: deliveryplanner.PackageService.UpdatePackage:output_type -> deliveryplanner.UpdatePackageResponse
-	14, // 27: deliveryplanner.PackageService.UpdatePackageStatus:output_type -> deliveryplanner.UpdatePackageStatusResponse
-	16, // 28: deliveryplanner.PackageService.MarkPackageAsDelivered:output_type -> deliveryplanner.MarkPackageAsDeliveredResponse
-	18, // 29: deliveryplanner.PackageService.DeletePackage:output_type -> deliveryplanner.DeletePackageResponse
-	20, // 30: deliveryplanner.PackageService.AssignToRoute:output_type -> deliveryplanner.AssignToRouteResponse
-	22, // 31: deliveryplanner.PackageService.GetPackagesByRoute:output_type -> deliveryplanner.GetPackagesByRouteResponse
-	22, // [22:32] is the sub-list for method output_type
-	12, // [12:22] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	33, // 0: deliveryplanner.TimeWindow.earliest:type_name -> google.protobuf.Timestamp
+	33, // 1: deliveryplanner.TimeWindow.latest:type_name -> google.protobuf.Timestamp
+	1,  // 2: deliveryplanner.DeliveryFailure.reason:type_name -> deliveryplanner.DeliveryFailureReason
+	33, // 3: deliveryplanner.DeliveryFailure.failed_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: deliveryplanner.ProofOfDelivery.location:type_name -> deliveryplanner.Location
+	5,  // 5: deliveryplanner.ProofOfDelivery.signature:type_name -> deliveryplanner.Attachment
+	5,  // 6: deliveryplanner.ProofOfDelivery.photo:type_name -> deliveryplanner.Attachment
+	33, // 7: deliveryplanner.ProofOfDelivery.captured_at:type_name -> google.protobuf.Timestamp
+	33, // 8: deliveryplanner.Package.delivery_timestamp:type_name -> google.protobuf.Timestamp
+	33, // 9: deliveryplanner.Package.created_at:type_name -> google.protobuf.Timestamp
+	33, // 10: deliveryplanner.Package.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 11: deliveryplanner.Package.location:type_name -> deliveryplanner.Location
+	3,  // 12: deliveryplanner.Package.delivery_window:type_name -> deliveryplanner.TimeWindow
+	0,  // 13: deliveryplanner.Package.status:type_name -> deliveryplanner.PackageStatus
+	4,  // 14: deliveryplanner.Package.last_failure:type_name -> deliveryplanner.DeliveryFailure
+	0,  // 15: deliveryplanner.PackageEvent.from_status:type_name -> deliveryplanner.PackageStatus
+	0,  // 16: deliveryplanner.PackageEvent.to_status:type_name -> deliveryplanner.PackageStatus
+	2,  // 17: deliveryplanner.PackageEvent.location:type_name -> deliveryplanner.Location
+	33, // 18: deliveryplanner.PackageEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 19: deliveryplanner.CreatePackageRequest.location:type_name -> deliveryplanner.Location
+	3,  // 20: deliveryplanner.CreatePackageRequest.delivery_window:type_name -> deliveryplanner.TimeWindow
+	7,  // 21: deliveryplanner.CreatePackageResponse.package:type_name -> deliveryplanner.Package
+	7,  // 22: deliveryplanner.GetPackageResponse.package:type_name -> deliveryplanner.Package
+	7,  // 23: deliveryplanner.GetPackageByTrackingNumberResponse.package:type_name -> deliveryplanner.Package
+	0,  // 24: deliveryplanner.ListPackagesRequest.status:type_name -> deliveryplanner.PackageStatus
+	33, // 25: deliveryplanner.ListPackagesRequest.created_from:type_name -> google.protobuf.Timestamp
+	33, // 26: deliveryplanner.ListPackagesRequest.created_to:type_name -> google.protobuf.Timestamp
+	7,  // 27: deliveryplanner.ListPackagesResponse.packages:type_name -> deliveryplanner.Package
+	2,  // 28: deliveryplanner.UpdatePackageRequest.location:type_name -> deliveryplanner.Location
+	3,  // 29: deliveryplanner.UpdatePackageRequest.delivery_window:type_name -> deliveryplanner.TimeWindow
+	7,  // 30: deliveryplanner.UpdatePackageResponse.package:type_name -> deliveryplanner.Package
+	0,  // 31: deliveryplanner.UpdatePackageStatusRequest.status:type_name -> deliveryplanner.PackageStatus
+	2,  // 32: deliveryplanner.UpdatePackageStatusRequest.location:type_name -> deliveryplanner.Location
+	7,  // 33: deliveryplanner.UpdatePackageStatusResponse.package:type_name -> deliveryplanner.Package
+	6,  // 34: deliveryplanner.MarkPackageAsDeliveredRequest.proof:type_name -> deliveryplanner.ProofOfDelivery
+	7,  // 35: deliveryplanner.MarkPackageAsDeliveredResponse.package:type_name -> deliveryplanner.Package
+	7,  // 36: deliveryplanner.GetPackagesByRouteResponse.packages:type_name -> deliveryplanner.Package
+	8,  // 37: deliveryplanner.GetPackageHistoryResponse.events:type_name -> deliveryplanner.PackageEvent
+	6,  // 38: deliveryplanner.GetProofOfDeliveryResponse.proof:type_name -> deliveryplanner.ProofOfDelivery
+	9,  // 39: deliveryplanner.PackageService.CreatePackage:input_type -> deliveryplanner.CreatePackageRequest
+	11, // 40: deliveryplanner.PackageService.GetPackage:input_type -> deliveryplanner.GetPackageRequest
+	13, // 41: deliveryplanner.PackageService.GetPackageByTrackingNumber:input_type -> deliveryplanner.GetPackageByTrackingNumberRequest
+	15, // 42: deliveryplanner.PackageService.ListPackages:input_type -> deliveryplanner.ListPackagesRequest
+	17, // 43: deliveryplanner.PackageService.UpdatePackage:input_type -> deliveryplanner.UpdatePackageRequest
+	19, // 44: deliveryplanner.PackageService.UpdatePackageStatus:input_type -> deliveryplanner.UpdatePackageStatusRequest
+	21, // 45: deliveryplanner.PackageService.MarkPackageAsDelivered:input_type -> deliveryplanner.MarkPackageAsDeliveredRequest
+	23, // 46: deliveryplanner.PackageService.DeletePackage:input_type -> deliveryplanner.DeletePackageRequest
+	25, // 47: deliveryplanner.PackageService.AssignToRoute:input_type -> deliveryplanner.AssignToRouteRequest
+	27, // 48: deliveryplanner.PackageService.GetPackagesByRoute:input_type -> deliveryplanner.GetPackagesByRouteRequest
+	29, // 49: deliveryplanner.PackageService.GetPackageHistory:input_type -> deliveryplanner.GetPackageHistoryRequest
+	31, // 50: deliveryplanner.PackageService.GetProofOfDelivery:input_type -> deliveryplanner.GetProofOfDeliveryRequest
+	10, // 51: deliveryplanner.PackageService.CreatePackage:output_type -> deliveryplanner.CreatePackageResponse
+	12, // 52: deliveryplanner.PackageService.GetPackage:output_type -> deliveryplanner.GetPackageResponse
+	14, // 53: deliveryplanner.PackageService.GetPackageByTrackingNumber:output_type -> deliveryplanner.GetPackageByTrackingNumberResponse
+	16, // 54: deliveryplanner.PackageService.ListPackages:output_type -> deliveryplanner.ListPackagesResponse
+	18, // 55: deliveryplanner.PackageService.UpdatePackage:output_type -> deliveryplanner.UpdatePackageResponse
+	20, // 56: deliveryplanner.PackageService.UpdatePackageStatus:output_type -> deliveryplanner.UpdatePackageStatusResponse
+	22, // 57: deliveryplanner.PackageService.MarkPackageAsDelivered:output_type -> deliveryplanner.MarkPackageAsDeliveredResponse
+	24, // 58: deliveryplanner.PackageService.DeletePackage:output_type -> deliveryplanner.DeletePackageResponse
+	26, // 59: deliveryplanner.PackageService.AssignToRoute:output_type -> deliveryplanner.AssignToRouteResponse
+	28, // 60: deliveryplanner.PackageService.GetPackagesByRoute:output_type -> deliveryplanner.GetPackagesByRouteResponse
+	30, // 61: deliveryplanner.PackageService.GetPackageHistory:output_type -> deliveryplanner.GetPackageHistoryResponse
+	32, // 62: deliveryplanner.PackageService.GetProofOfDelivery:output_type -> deliveryplanner.GetProofOfDeliveryResponse
+	51, // [51:63] is the sub-list for method output_type
+	39, // [39:51] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_package_proto_init() }
@@ -1627,7 +2723,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Package); i {
+			switch v := v.(*TimeWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1639,7 +2735,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePackageRequest); i {
+			switch v := v.(*DeliveryFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1651,7 +2747,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePackageResponse); i {
+			switch v := v.(*Attachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1663,7 +2759,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPackageRequest); i {
+			switch v := v.(*ProofOfDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1675,7 +2771,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPackageResponse); i {
+			switch v := v.(*Package); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1687,7 +2783,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPackageByTrackingNumberRequest); i {
+			switch v := v.(*PackageEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1699,7 +2795,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPackageByTrackingNumberResponse); i {
+			switch v := v.(*CreatePackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1711,7 +2807,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPackagesRequest); i {
+			switch v := v.(*CreatePackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1723,7 +2819,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPackagesResponse); i {
+			switch v := v.(*GetPackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1735,7 +2831,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageRequest); i {
+			switch v := v.(*GetPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1747,7 +2843,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageResponse); i {
+			switch v := v.(*GetPackageByTrackingNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,7 +2855,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageStatusRequest); i {
+			switch v := v.(*GetPackageByTrackingNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1771,7 +2867,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageStatusResponse); i {
+			switch v := v.(*ListPackagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1783,7 +2879,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkPackageAsDeliveredRequest); i {
+			switch v := v.(*ListPackagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1795,7 +2891,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkPackageAsDeliveredResponse); i {
+			switch v := v.(*UpdatePackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1807,7 +2903,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePackageRequest); i {
+			switch v := v.(*UpdatePackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,7 +2915,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePackageResponse); i {
+			switch v := v.(*UpdatePackageStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,7 +2927,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignToRouteRequest); i {
+			switch v := v.(*UpdatePackageStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,7 +2939,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignToRouteResponse); i {
+			switch v := v.(*MarkPackageAsDeliveredRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1855,7 +2951,7 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPackagesByRouteRequest); i {
+			switch v := v.(*MarkPackageAsDeliveredResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1867,6 +2963,66 @@ func file_proto_package_proto_init() {
 			}
 		}
 		file_proto_package_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignToRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignToRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPackagesByRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPackagesByRouteResponse); i {
 			case 0:
 				return &v.state
@@ -1878,14 +3034,62 @@ func file_proto_package_proto_init() {
 				return nil
 			}
 		}
+		file_proto_package_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPackageHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPackageHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofOfDeliveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_package_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofOfDeliveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_package_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
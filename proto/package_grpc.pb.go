@@ -28,6 +28,8 @@ type PackageServiceClient interface {
 	DeletePackage(ctx context.Context, in *DeletePackageRequest, opts ...grpc.CallOption) (*DeletePackageResponse, error)
 	AssignToRoute(ctx context.Context, in *AssignToRouteRequest, opts ...grpc.CallOption) (*AssignToRouteResponse, error)
 	GetPackagesByRoute(ctx context.Context, in *GetPackagesByRouteRequest, opts ...grpc.CallOption) (*GetPackagesByRouteResponse, error)
+	GetPackageHistory(ctx context.Context, in *GetPackageHistoryRequest, opts ...grpc.CallOption) (*GetPackageHistoryResponse, error)
+	GetProofOfDelivery(ctx context.Context, in *GetProofOfDeliveryRequest, opts ...grpc.CallOption) (*GetProofOfDeliveryResponse, error)
 }
 
 type packageServiceClient struct {
@@ -128,6 +130,24 @@ func (c *packageServiceClient) GetPackagesByRoute(ctx context.Context, in *GetPa
 	return out, nil
 }
 
+func (c *packageServiceClient) GetPackageHistory(ctx context.Context, in *GetPackageHistoryRequest, opts ...grpc.CallOption) (*GetPackageHistoryResponse, error) {
+	out := new(GetPackageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.PackageService/GetPackageHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) GetProofOfDelivery(ctx context.Context, in *GetProofOfDeliveryRequest, opts ...grpc.CallOption) (*GetProofOfDeliveryResponse, error) {
+	out := new(GetProofOfDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.PackageService/GetProofOfDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PackageServiceServer is the server API for PackageService service.
 // All implementations must embed UnimplementedPackageServiceServer
 // for forward compatibility
@@ -142,6 +162,8 @@ type PackageServiceServer interface {
 	DeletePackage(context.Context, *DeletePackageRequest) (*DeletePackageResponse, error)
 	AssignToRoute(context.Context, *AssignToRouteRequest) (*AssignToRouteResponse, error)
 	GetPackagesByRoute(context.Context, *GetPackagesByRouteRequest) (*GetPackagesByRouteResponse, error)
+	GetPackageHistory(context.Context, *GetPackageHistoryRequest) (*GetPackageHistoryResponse, error)
+	GetProofOfDelivery(context.Context, *GetProofOfDeliveryRequest) (*GetProofOfDeliveryResponse, error)
 	mustEmbedUnimplementedPackageServiceServer()
 }
 
@@ -179,6 +201,12 @@ func (UnimplementedPackageServiceServer) AssignToRoute(context.Context, *AssignT
 func (UnimplementedPackageServiceServer) GetPackagesByRoute(context.Context, *GetPackagesByRouteRequest) (*GetPackagesByRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackagesByRoute not implemented")
 }
+func (UnimplementedPackageServiceServer) GetPackageHistory(context.Context, *GetPackageHistoryRequest) (*GetPackageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageHistory not implemented")
+}
+func (UnimplementedPackageServiceServer) GetProofOfDelivery(context.Context, *GetProofOfDeliveryRequest) (*GetProofOfDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofOfDelivery not implemented")
+}
 func (UnimplementedPackageServiceServer) mustEmbedUnimplementedPackageServiceServer() {}
 
 // UnsafePackageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -372,6 +400,42 @@ func _PackageService_GetPackagesByRoute_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackageService_GetPackageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetPackageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.PackageService/GetPackageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetPackageHistory(ctx, req.(*GetPackageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_GetProofOfDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofOfDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetProofOfDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.PackageService/GetProofOfDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetProofOfDelivery(ctx, req.(*GetProofOfDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PackageService_ServiceDesc is the grpc.ServiceDesc for PackageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +483,14 @@ var PackageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackagesByRoute",
 			Handler:    _PackageService_GetPackagesByRoute_Handler,
 		},
+		{
+			MethodName: "GetPackageHistory",
+			Handler:    _PackageService_GetPackageHistory_Handler,
+		},
+		{
+			MethodName: "GetProofOfDelivery",
+			Handler:    _PackageService_GetProofOfDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/package.proto",
@@ -21,6 +21,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RouteStatus represents the current status of a route
+type RouteStatus int32
+
+const (
+	RouteStatus_ROUTE_STATUS_UNSPECIFIED RouteStatus = 0
+	RouteStatus_ROUTE_STATUS_PENDING     RouteStatus = 1
+	RouteStatus_ROUTE_STATUS_ACTIVE      RouteStatus = 2
+	RouteStatus_ROUTE_STATUS_COMPLETED   RouteStatus = 3
+	RouteStatus_ROUTE_STATUS_CANCELLED   RouteStatus = 4
+	RouteStatus_ROUTE_STATUS_SUSPENDED   RouteStatus = 5
+)
+
+// Enum value maps for RouteStatus.
+var (
+	RouteStatus_name = map[int32]string{
+		0: "ROUTE_STATUS_UNSPECIFIED",
+		1: "ROUTE_STATUS_PENDING",
+		2: "ROUTE_STATUS_ACTIVE",
+		3: "ROUTE_STATUS_COMPLETED",
+		4: "ROUTE_STATUS_CANCELLED",
+		5: "ROUTE_STATUS_SUSPENDED",
+	}
+	RouteStatus_value = map[string]int32{
+		"ROUTE_STATUS_UNSPECIFIED": 0,
+		"ROUTE_STATUS_PENDING":     1,
+		"ROUTE_STATUS_ACTIVE":      2,
+		"ROUTE_STATUS_COMPLETED":   3,
+		"ROUTE_STATUS_CANCELLED":   4,
+		"ROUTE_STATUS_SUSPENDED":   5,
+	}
+)
+
+func (x RouteStatus) Enum() *RouteStatus {
+	p := new(RouteStatus)
+	*p = x
+	return p
+}
+
+func (x RouteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RouteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_route_proto_enumTypes[0].Descriptor()
+}
+
+func (RouteStatus) Type() protoreflect.EnumType {
+	return &file_proto_route_proto_enumTypes[0]
+}
+
+func (x RouteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RouteStatus.Descriptor instead.
+func (RouteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{0}
+}
+
 // PackageRoute represents a package assigned to a route
 type PackageRoute struct {
 	state         protoimpl.MessageState
@@ -31,6 +90,11 @@ type PackageRoute struct {
 	OrderInRoute      int32                  `protobuf:"varint,2,opt,name=order_in_route,json=orderInRoute,proto3" json:"order_in_route,omitempty"`
 	Delivered         bool                   `protobuf:"varint,3,opt,name=delivered,proto3" json:"delivered,omitempty"`
 	DeliveryTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=delivery_timestamp,json=deliveryTimestamp,proto3" json:"delivery_timestamp,omitempty"`
+	PlannedArrival    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=planned_arrival,json=plannedArrival,proto3" json:"planned_arrival,omitempty"`
+	OutsideTimeWindow bool                   `protobuf:"varint,6,opt,name=outside_time_window,json=outsideTimeWindow,proto3" json:"outside_time_window,omitempty"`
+	ProjectedArrival  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=projected_arrival,json=projectedArrival,proto3" json:"projected_arrival,omitempty"`
+	Failed            bool                   `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	FailureReason     DeliveryFailureReason  `protobuf:"varint,9,opt,name=failure_reason,json=failureReason,proto3,enum=deliveryplanner.DeliveryFailureReason" json:"failure_reason,omitempty"`
 }
 
 func (x *PackageRoute) Reset() {
@@ -93,21 +157,64 @@ func (x *PackageRoute) GetDeliveryTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PackageRoute) GetPlannedArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlannedArrival
+	}
+	return nil
+}
+
+func (x *PackageRoute) GetOutsideTimeWindow() bool {
+	if x != nil {
+		return x.OutsideTimeWindow
+	}
+	return false
+}
+
+func (x *PackageRoute) GetProjectedArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedArrival
+	}
+	return nil
+}
+
+func (x *PackageRoute) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *PackageRoute) GetFailureReason() DeliveryFailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return DeliveryFailureReason_DELIVERY_FAILURE_REASON_UNSPECIFIED
+}
+
 // Route represents a delivery route
 type Route struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DriverId            string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	Date                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Packages            []*PackageRoute        `protobuf:"bytes,4,rep,name=packages,proto3" json:"packages,omitempty"`
-	EstimatedDistanceKm float32                `protobuf:"fixed32,5,opt,name=estimated_distance_km,json=estimatedDistanceKm,proto3" json:"estimated_distance_km,omitempty"`
-	EstimatedTimeMin    int32                  `protobuf:"varint,6,opt,name=estimated_time_min,json=estimatedTimeMin,proto3" json:"estimated_time_min,omitempty"`
-	Completed           bool                   `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DriverId             string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Date                 *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Packages             []*PackageRoute        `protobuf:"bytes,4,rep,name=packages,proto3" json:"packages,omitempty"`
+	EstimatedDistanceKm  float32                `protobuf:"fixed32,5,opt,name=estimated_distance_km,json=estimatedDistanceKm,proto3" json:"estimated_distance_km,omitempty"`
+	EstimatedTimeMin     int32                  `protobuf:"varint,6,opt,name=estimated_time_min,json=estimatedTimeMin,proto3" json:"estimated_time_min,omitempty"`
+	Completed            bool                   `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartLocation        *Location              `protobuf:"bytes,10,opt,name=start_location,json=startLocation,proto3" json:"start_location,omitempty"`
+	LoadWeightKg         float64                `protobuf:"fixed64,11,opt,name=load_weight_kg,json=loadWeightKg,proto3" json:"load_weight_kg,omitempty"`
+	LoadVolumeM3         float64                `protobuf:"fixed64,12,opt,name=load_volume_m3,json=loadVolumeM3,proto3" json:"load_volume_m3,omitempty"`
+	WeightUtilizationPct float64                `protobuf:"fixed64,13,opt,name=weight_utilization_pct,json=weightUtilizationPct,proto3" json:"weight_utilization_pct,omitempty"`
+	VolumeUtilizationPct float64                `protobuf:"fixed64,14,opt,name=volume_utilization_pct,json=volumeUtilizationPct,proto3" json:"volume_utilization_pct,omitempty"`
+	Status               RouteStatus            `protobuf:"varint,15,opt,name=status,proto3,enum=deliveryplanner.RouteStatus" json:"status,omitempty"`
+	// Number of changes stored for the route; pass it back in updates to detect concurrent edits
+	Version int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Route) Reset() {
@@ -205,14 +312,64 @@ func (x *Route) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Route) GetStartLocation() *Location {
+	if x != nil {
+		return x.StartLocation
+	}
+	return nil
+}
+
+func (x *Route) GetLoadWeightKg() float64 {
+	if x != nil {
+		return x.LoadWeightKg
+	}
+	return 0
+}
+
+func (x *Route) GetLoadVolumeM3() float64 {
+	if x != nil {
+		return x.LoadVolumeM3
+	}
+	return 0
+}
+
+func (x *Route) GetWeightUtilizationPct() float64 {
+	if x != nil {
+		return x.WeightUtilizationPct
+	}
+	return 0
+}
+
+func (x *Route) GetVolumeUtilizationPct() float64 {
+	if x != nil {
+		return x.VolumeUtilizationPct
+	}
+	return 0
+}
+
+func (x *Route) GetStatus() RouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RouteStatus_ROUTE_STATUS_UNSPECIFIED
+}
+
+func (x *Route) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateRouteRequest represents the request to create a route
 type CreateRouteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DriverId string                 `protobuf:"bytes,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	Date     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	DriverId      string                 `protobuf:"bytes,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	StartLocation *Location              `protobuf:"bytes,3,opt,name=start_location,json=startLocation,proto3" json:"start_location,omitempty"`
 }
 
 func (x *CreateRouteRequest) Reset() {
@@ -261,6 +418,13 @@ func (x *CreateRouteRequest) GetDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateRouteRequest) GetStartLocation() *Location {
+	if x != nil {
+		return x.StartLocation
+	}
+	return nil
+}
+
 // CreateRouteResponse represents the response after creating a route
 type CreateRouteResponse struct {
 	state         protoimpl.MessageState
@@ -405,17 +569,129 @@ func (x *GetRouteResponse) GetRoute() *Route {
 	return nil
 }
 
+// GetRouteETARequest represents the request to get the arrival projections of a route
+type GetRouteETARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRouteETARequest) Reset() {
+	*x = GetRouteETARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRouteETARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteETARequest) ProtoMessage() {}
+
+func (x *GetRouteETARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteETARequest.ProtoReflect.Descriptor instead.
+func (*GetRouteETARequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRouteETARequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetRouteETAResponse represents the response with a route and its projected arrivals
+type GetRouteETAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route *Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *GetRouteETAResponse) Reset() {
+	*x = GetRouteETAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRouteETAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteETAResponse) ProtoMessage() {}
+
+func (x *GetRouteETAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteETAResponse.ProtoReflect.Descriptor instead.
+func (*GetRouteETAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRouteETAResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
 // ListRoutesRequest represents the request to list routes
 type ListRoutesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of routes to return; defaults to 50, capped at 200
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Cursor returned as next_page_token by a previous call; empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// List the newest routes first
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Only list routes in this status
+	Status RouteStatus `protobuf:"varint,4,opt,name=status,proto3,enum=deliveryplanner.RouteStatus" json:"status,omitempty"`
+	// Only list routes assigned to this driver
+	DriverId string `protobuf:"bytes,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	// Only list routes scheduled within this range; either bound may be left unset
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// Only list routes with stops, none left to attempt (true), or with a stop neither delivered nor failed (false)
+	Delivered *bool `protobuf:"varint,8,opt,name=delivered,proto3,oneof" json:"delivered,omitempty"`
 }
 
 func (x *ListRoutesRequest) Reset() {
 	*x = ListRoutesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[6]
+		mi := &file_proto_route_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +704,7 @@ func (x *ListRoutesRequest) String() string {
 func (*ListRoutesRequest) ProtoMessage() {}
 
 func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[6]
+	mi := &file_proto_route_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +717,63 @@ func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoutesRequest.ProtoReflect.Descriptor instead.
 func (*ListRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{6}
+	return file_proto_route_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRoutesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoutesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRoutesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListRoutesRequest) GetStatus() RouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RouteStatus_ROUTE_STATUS_UNSPECIFIED
+}
+
+func (x *ListRoutesRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ListRoutesRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ListRoutesRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ListRoutesRequest) GetDelivered() bool {
+	if x != nil && x.Delivered != nil {
+		return *x.Delivered
+	}
+	return false
 }
 
 // ListRoutesResponse represents the response after listing routes
@@ -451,12 +783,14 @@ type ListRoutesResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Cursor for the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListRoutesResponse) Reset() {
 	*x = ListRoutesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[7]
+		mi := &file_proto_route_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +803,7 @@ func (x *ListRoutesResponse) String() string {
 func (*ListRoutesResponse) ProtoMessage() {}
 
 func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[7]
+	mi := &file_proto_route_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +816,7 @@ func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoutesResponse.ProtoReflect.Descriptor instead.
 func (*ListRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{7}
+	return file_proto_route_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListRoutesResponse) GetRoutes() []*Route {
@@ -492,23 +826,34 @@ func (x *ListRoutesResponse) GetRoutes() []*Route {
 	return nil
 }
 
+func (x *ListRoutesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // UpdateRouteRequest represents the request to update a route
 type UpdateRouteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DriverId            string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	Date                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	EstimatedDistanceKm float64                `protobuf:"fixed64,4,opt,name=estimated_distance_km,json=estimatedDistanceKm,proto3" json:"estimated_distance_km,omitempty"`
-	EstimatedTimeMin    int32                  `protobuf:"varint,5,opt,name=estimated_time_min,json=estimatedTimeMin,proto3" json:"estimated_time_min,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DriverId string `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	// Required
+	Date *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// Ignored: the estimates are recalculated for the new driver and date
+	EstimatedDistanceKm float64 `protobuf:"fixed64,4,opt,name=estimated_distance_km,json=estimatedDistanceKm,proto3" json:"estimated_distance_km,omitempty"`
+	EstimatedTimeMin    int32   `protobuf:"varint,5,opt,name=estimated_time_min,json=estimatedTimeMin,proto3" json:"estimated_time_min,omitempty"`
+	// Version the update is based on; it fails if the route changed since. 0 skips the check
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateRouteRequest) Reset() {
 	*x = UpdateRouteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[8]
+		mi := &file_proto_route_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +866,7 @@ func (x *UpdateRouteRequest) String() string {
 func (*UpdateRouteRequest) ProtoMessage() {}
 
 func (x *UpdateRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[8]
+	mi := &file_proto_route_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +879,7 @@ func (x *UpdateRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRouteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRouteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{8}
+	return file_proto_route_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateRouteRequest) GetId() string {
@@ -572,6 +917,13 @@ func (x *UpdateRouteRequest) GetEstimatedTimeMin() int32 {
 	return 0
 }
 
+func (x *UpdateRouteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // UpdateRouteResponse represents the response after updating a route
 type UpdateRouteResponse struct {
 	state         protoimpl.MessageState
@@ -584,7 +936,7 @@ type UpdateRouteResponse struct {
 func (x *UpdateRouteResponse) Reset() {
 	*x = UpdateRouteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[9]
+		mi := &file_proto_route_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +949,7 @@ func (x *UpdateRouteResponse) String() string {
 func (*UpdateRouteResponse) ProtoMessage() {}
 
 func (x *UpdateRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[9]
+	mi := &file_proto_route_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +962,7 @@ func (x *UpdateRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRouteResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRouteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{9}
+	return file_proto_route_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateRouteResponse) GetRoute() *Route {
@@ -632,7 +984,7 @@ type MarkRouteAsCompletedRequest struct {
 func (x *MarkRouteAsCompletedRequest) Reset() {
 	*x = MarkRouteAsCompletedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[10]
+		mi := &file_proto_route_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +997,7 @@ func (x *MarkRouteAsCompletedRequest) String() string {
 func (*MarkRouteAsCompletedRequest) ProtoMessage() {}
 
 func (x *MarkRouteAsCompletedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[10]
+	mi := &file_proto_route_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +1010,7 @@ func (x *MarkRouteAsCompletedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkRouteAsCompletedRequest.ProtoReflect.Descriptor instead.
 func (*MarkRouteAsCompletedRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{10}
+	return file_proto_route_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MarkRouteAsCompletedRequest) GetId() string {
@@ -680,7 +1032,7 @@ type MarkRouteAsCompletedResponse struct {
 func (x *MarkRouteAsCompletedResponse) Reset() {
 	*x = MarkRouteAsCompletedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[11]
+		mi := &file_proto_route_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +1045,7 @@ func (x *MarkRouteAsCompletedResponse) String() string {
 func (*MarkRouteAsCompletedResponse) ProtoMessage() {}
 
 func (x *MarkRouteAsCompletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[11]
+	mi := &file_proto_route_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +1058,7 @@ func (x *MarkRouteAsCompletedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkRouteAsCompletedResponse.ProtoReflect.Descriptor instead.
 func (*MarkRouteAsCompletedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{11}
+	return file_proto_route_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MarkRouteAsCompletedResponse) GetRoute() *Route {
@@ -716,33 +1068,137 @@ func (x *MarkRouteAsCompletedResponse) GetRoute() *Route {
 	return nil
 }
 
-// AddPackagesToRouteRequest represents the request to add packages to a route
-type AddPackagesToRouteRequest struct {
+// UpdateRouteStatusRequest represents the request to move a route to a new status
+type UpdateRouteStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RouteId    string   `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
-	PackageIds []string `protobuf:"bytes,2,rep,name=package_ids,json=packageIds,proto3" json:"package_ids,omitempty"`
+	Id     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status RouteStatus `protobuf:"varint,2,opt,name=status,proto3,enum=deliveryplanner.RouteStatus" json:"status,omitempty"`
 }
 
-func (x *AddPackagesToRouteRequest) Reset() {
-	*x = AddPackagesToRouteRequest{}
+func (x *UpdateRouteStatusRequest) Reset() {
+	*x = UpdateRouteStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[12]
+		mi := &file_proto_route_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPackagesToRouteRequest) String() string {
+func (x *UpdateRouteStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPackagesToRouteRequest) ProtoMessage() {}
+func (*UpdateRouteStatusRequest) ProtoMessage() {}
+
+func (x *UpdateRouteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRouteStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRouteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateRouteStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRouteStatusRequest) GetStatus() RouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RouteStatus_ROUTE_STATUS_UNSPECIFIED
+}
+
+// UpdateRouteStatusResponse represents the response after updating a route's status
+type UpdateRouteStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route *Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *UpdateRouteStatusResponse) Reset() {
+	*x = UpdateRouteStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRouteStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRouteStatusResponse) ProtoMessage() {}
+
+func (x *UpdateRouteStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRouteStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRouteStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateRouteStatusResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+// AddPackagesToRouteRequest represents the request to add packages to a route
+type AddPackagesToRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId    string   `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	PackageIds []string `protobuf:"bytes,2,rep,name=package_ids,json=packageIds,proto3" json:"package_ids,omitempty"`
+}
+
+func (x *AddPackagesToRouteRequest) Reset() {
+	*x = AddPackagesToRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPackagesToRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPackagesToRouteRequest) ProtoMessage() {}
 
 func (x *AddPackagesToRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[12]
+	mi := &file_proto_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1211,7 @@ func (x *AddPackagesToRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPackagesToRouteRequest.ProtoReflect.Descriptor instead.
 func (*AddPackagesToRouteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{12}
+	return file_proto_route_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AddPackagesToRouteRequest) GetRouteId() string {
@@ -784,7 +1240,7 @@ type AddPackagesToRouteResponse struct {
 func (x *AddPackagesToRouteResponse) Reset() {
 	*x = AddPackagesToRouteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[13]
+		mi := &file_proto_route_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -797,7 +1253,7 @@ func (x *AddPackagesToRouteResponse) String() string {
 func (*AddPackagesToRouteResponse) ProtoMessage() {}
 
 func (x *AddPackagesToRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[13]
+	mi := &file_proto_route_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1266,7 @@ func (x *AddPackagesToRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPackagesToRouteResponse.ProtoReflect.Descriptor instead.
 func (*AddPackagesToRouteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{13}
+	return file_proto_route_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddPackagesToRouteResponse) GetRoute() *Route {
@@ -820,21 +1276,134 @@ func (x *AddPackagesToRouteResponse) GetRoute() *Route {
 	return nil
 }
 
+// OptimizeRouteRequest represents the request to re-sequence the stops of a route
+type OptimizeRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *OptimizeRouteRequest) Reset() {
+	*x = OptimizeRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRouteRequest) ProtoMessage() {}
+
+func (x *OptimizeRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRouteRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OptimizeRouteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// OptimizeRouteResponse represents the response after optimizing a route
+type OptimizeRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route            *Route  `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	DistanceBeforeKm float64 `protobuf:"fixed64,2,opt,name=distance_before_km,json=distanceBeforeKm,proto3" json:"distance_before_km,omitempty"`
+	DistanceAfterKm  float64 `protobuf:"fixed64,3,opt,name=distance_after_km,json=distanceAfterKm,proto3" json:"distance_after_km,omitempty"`
+}
+
+func (x *OptimizeRouteResponse) Reset() {
+	*x = OptimizeRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRouteResponse) ProtoMessage() {}
+
+func (x *OptimizeRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRouteResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OptimizeRouteResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *OptimizeRouteResponse) GetDistanceBeforeKm() float64 {
+	if x != nil {
+		return x.DistanceBeforeKm
+	}
+	return 0
+}
+
+func (x *OptimizeRouteResponse) GetDistanceAfterKm() float64 {
+	if x != nil {
+		return x.DistanceAfterKm
+	}
+	return 0
+}
+
 // UpdatePackageDeliveryStatusRequest represents the request to update a package's delivery status in a route
 type UpdatePackageDeliveryStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RouteId   string `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
-	PackageId string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	Delivered bool   `protobuf:"varint,3,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	RouteId   string           `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	PackageId string           `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Delivered bool             `protobuf:"varint,3,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Proof     *ProofOfDelivery `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (x *UpdatePackageDeliveryStatusRequest) Reset() {
 	*x = UpdatePackageDeliveryStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[14]
+		mi := &file_proto_route_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -847,7 +1416,7 @@ func (x *UpdatePackageDeliveryStatusRequest) String() string {
 func (*UpdatePackageDeliveryStatusRequest) ProtoMessage() {}
 
 func (x *UpdatePackageDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[14]
+	mi := &file_proto_route_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1429,7 @@ func (x *UpdatePackageDeliveryStatusRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdatePackageDeliveryStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePackageDeliveryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{14}
+	return file_proto_route_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdatePackageDeliveryStatusRequest) GetRouteId() string {
@@ -884,6 +1453,13 @@ func (x *UpdatePackageDeliveryStatusRequest) GetDelivered() bool {
 	return false
 }
 
+func (x *UpdatePackageDeliveryStatusRequest) GetProof() *ProofOfDelivery {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 // UpdatePackageDeliveryStatusResponse represents the response after updating a package's delivery status
 type UpdatePackageDeliveryStatusResponse struct {
 	state         protoimpl.MessageState
@@ -896,7 +1472,7 @@ type UpdatePackageDeliveryStatusResponse struct {
 func (x *UpdatePackageDeliveryStatusResponse) Reset() {
 	*x = UpdatePackageDeliveryStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[15]
+		mi := &file_proto_route_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1485,7 @@ func (x *UpdatePackageDeliveryStatusResponse) String() string {
 func (*UpdatePackageDeliveryStatusResponse) ProtoMessage() {}
 
 func (x *UpdatePackageDeliveryStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[15]
+	mi := &file_proto_route_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1498,7 @@ func (x *UpdatePackageDeliveryStatusResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdatePackageDeliveryStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePackageDeliveryStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{15}
+	return file_proto_route_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdatePackageDeliveryStatusResponse) GetRoute() *Route {
@@ -932,6 +1508,126 @@ func (x *UpdatePackageDeliveryStatusResponse) GetRoute() *Route {
 	return nil
 }
 
+// RecordFailedDeliveryAttemptRequest represents the request to record a failed delivery attempt at a route stop
+type RecordFailedDeliveryAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId   string                `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	PackageId string                `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Reason    DeliveryFailureReason `protobuf:"varint,3,opt,name=reason,proto3,enum=deliveryplanner.DeliveryFailureReason" json:"reason,omitempty"`
+	Note      string                `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) Reset() {
+	*x = RecordFailedDeliveryAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordFailedDeliveryAttemptRequest) ProtoMessage() {}
+
+func (x *RecordFailedDeliveryAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordFailedDeliveryAttemptRequest.ProtoReflect.Descriptor instead.
+func (*RecordFailedDeliveryAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) GetReason() DeliveryFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DeliveryFailureReason_DELIVERY_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *RecordFailedDeliveryAttemptRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// RecordFailedDeliveryAttemptResponse represents the response after recording a failed delivery attempt
+type RecordFailedDeliveryAttemptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package *Package `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+}
+
+func (x *RecordFailedDeliveryAttemptResponse) Reset() {
+	*x = RecordFailedDeliveryAttemptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordFailedDeliveryAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordFailedDeliveryAttemptResponse) ProtoMessage() {}
+
+func (x *RecordFailedDeliveryAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordFailedDeliveryAttemptResponse.ProtoReflect.Descriptor instead.
+func (*RecordFailedDeliveryAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RecordFailedDeliveryAttemptResponse) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
 // DeleteRouteRequest represents the request to delete a route
 type DeleteRouteRequest struct {
 	state         protoimpl.MessageState
@@ -944,7 +1640,7 @@ type DeleteRouteRequest struct {
 func (x *DeleteRouteRequest) Reset() {
 	*x = DeleteRouteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[16]
+		mi := &file_proto_route_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +1653,7 @@ func (x *DeleteRouteRequest) String() string {
 func (*DeleteRouteRequest) ProtoMessage() {}
 
 func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[16]
+	mi := &file_proto_route_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1666,7 @@ func (x *DeleteRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRouteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{16}
+	return file_proto_route_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteRouteRequest) GetId() string {
@@ -990,7 +1686,7 @@ type DeleteRouteResponse struct {
 func (x *DeleteRouteResponse) Reset() {
 	*x = DeleteRouteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_proto_msgTypes[17]
+		mi := &file_proto_route_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1003,7 +1699,7 @@ func (x *DeleteRouteResponse) String() string {
 func (*DeleteRouteResponse) ProtoMessage() {}
 
 func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_proto_msgTypes[17]
+	mi := &file_proto_route_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1712,7 @@ func (x *DeleteRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRouteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRouteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_route_proto_rawDescGZIP(), []int{17}
+	return file_proto_route_proto_rawDescGZIP(), []int{25}
 }
 
 var File_proto_route_proto protoreflect.FileDescriptor
@@ -1026,89 +1722,176 @@ var file_proto_route_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x95, 0x03, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x03, 0x0a, 0x0c, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x49,
+	0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x75, 0x74,
+	0x73, 0x69, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x47,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x4d, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xdf,
+	0x05, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6b, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x33,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4d, 0x33, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x74, 0x69, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x63, 0x74,
+	0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xa3, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x54, 0x41, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x45, 0x54, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
 	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
-	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0x43, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44,
-	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x12,
-	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
-	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x22, 0x43, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x21,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22,
+	0x6c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xed, 0x01,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x4c, 0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22,
-	0x2d, 0x0a, 0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c,
-	0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x60, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x49, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
 	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
 	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x19,
@@ -1122,79 +1905,152 @@ var file_proto_route_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x22, 0x7c, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22,
-	0x53, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xc3, 0x06, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12,
-	0x22, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x65, 0x22, 0x26, 0x0a, 0x14, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x4f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4b, 0x6d, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4b, 0x6d, 0x22, 0xb4, 0x01, 0x0a, 0x22,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x4f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x22, 0x53, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x23,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xb2, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55,
+	0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x32, 0xfc, 0x09, 0x0a, 0x0c, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
 	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2c,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8a,
-	0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33,
-	0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x63, 0x61, 0x6e, 0x6d, 0x2f, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x6f, 0x6c, 0x61,
-	0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x54, 0x41, 0x12, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x45, 0x54, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x54, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x4d, 0x61,
+	0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x73, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x54,
+	0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x25, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x33, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x8a, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x33, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x63, 0x61, 0x6e, 0x6d, 0x2f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x6f, 0x6c,
+	0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1209,64 +2065,102 @@ func file_proto_route_proto_rawDescGZIP() []byte {
 	return file_proto_route_proto_rawDescData
 }
 
-var file_proto_route_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_route_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_route_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_proto_route_proto_goTypes = []interface{}{
-	(*PackageRoute)(nil),                        // 0: deliveryplanner.PackageRoute
-	(*Route)(nil),                               // 1: deliveryplanner.Route
-	(*CreateRouteRequest)(nil),                  // 2: deliveryplanner.CreateRouteRequest
-	(*CreateRouteResponse)(nil),                 // 3: deliveryplanner.CreateRouteResponse
-	(*GetRouteRequest)(nil),                     // 4: deliveryplanner.GetRouteRequest
-	(*GetRouteResponse)(nil),                    // 5: deliveryplanner.GetRouteResponse
-	(*ListRoutesRequest)(nil),                   // 6: deliveryplanner.ListRoutesRequest
-	(*ListRoutesResponse)(nil),                  // 7: deliveryplanner.ListRoutesResponse
-	(*UpdateRouteRequest)(nil),                  // 8: deliveryplanner.UpdateRouteRequest
-	(*UpdateRouteResponse)(nil),                 // 9: deliveryplanner.UpdateRouteResponse
-	(*MarkRouteAsCompletedRequest)(nil),         // 10: deliveryplanner.MarkRouteAsCompletedRequest
-	(*MarkRouteAsCompletedResponse)(nil),        // 11: deliveryplanner.MarkRouteAsCompletedResponse
-	(*AddPackagesToRouteRequest)(nil),           // 12: deliveryplanner.AddPackagesToRouteRequest
-	(*AddPackagesToRouteResponse)(nil),          // 13: deliveryplanner.AddPackagesToRouteResponse
-	(*UpdatePackageDeliveryStatusRequest)(nil),  // 14: deliveryplanner.UpdatePackageDeliveryStatusRequest
-	(*UpdatePackageDeliveryStatusResponse)(nil), // 15: deliveryplanner.UpdatePackageDeliveryStatusResponse
-	(*DeleteRouteRequest)(nil),                  // 16: deliveryplanner.DeleteRouteRequest
-	(*DeleteRouteResponse)(nil),                 // 17: deliveryplanner.DeleteRouteResponse
-	(*timestamppb.Timestamp)(nil),               // 18: google.protobuf.Timestamp
+	(RouteStatus)(0),                            // 0: deliveryplanner.RouteStatus
+	(*PackageRoute)(nil),                        // 1: deliveryplanner.PackageRoute
+	(*Route)(nil),                               // 2: deliveryplanner.Route
+	(*CreateRouteRequest)(nil),                  // 3: deliveryplanner.CreateRouteRequest
+	(*CreateRouteResponse)(nil),                 // 4: deliveryplanner.CreateRouteResponse
+	(*GetRouteRequest)(nil),                     // 5: deliveryplanner.GetRouteRequest
+	(*GetRouteResponse)(nil),                    // 6: deliveryplanner.GetRouteResponse
+	(*GetRouteETARequest)(nil),                  // 7: deliveryplanner.GetRouteETARequest
+	(*GetRouteETAResponse)(nil),                 // 8: deliveryplanner.GetRouteETAResponse
+	(*ListRoutesRequest)(nil),                   // 9: deliveryplanner.ListRoutesRequest
+	(*ListRoutesResponse)(nil),                  // 10: deliveryplanner.ListRoutesResponse
+	(*UpdateRouteRequest)(nil),                  // 11: deliveryplanner.UpdateRouteRequest
+	(*UpdateRouteResponse)(nil),                 // 12: deliveryplanner.UpdateRouteResponse
+	(*MarkRouteAsCompletedRequest)(nil),         // 13: deliveryplanner.MarkRouteAsCompletedRequest
+	(*MarkRouteAsCompletedResponse)(nil),        // 14: deliveryplanner.MarkRouteAsCompletedResponse
+	(*UpdateRouteStatusRequest)(nil),            // 15: deliveryplanner.UpdateRouteStatusRequest
+	(*UpdateRouteStatusResponse)(nil),           // 16: deliveryplanner.UpdateRouteStatusResponse
+	(*AddPackagesToRouteRequest)(nil),           // 17: deliveryplanner.AddPackagesToRouteRequest
+	(*AddPackagesToRouteResponse)(nil),          // 18: deliveryplanner.AddPackagesToRouteResponse
+	(*OptimizeRouteRequest)(nil),                // 19: deliveryplanner.OptimizeRouteRequest
+	(*OptimizeRouteResponse)(nil),               // 20: deliveryplanner.OptimizeRouteResponse
+	(*UpdatePackageDeliveryStatusRequest)(nil),  // 21: deliveryplanner.UpdatePackageDeliveryStatusRequest
+	(*UpdatePackageDeliveryStatusResponse)(nil), // 22: deliveryplanner.UpdatePackageDeliveryStatusResponse
+	(*RecordFailedDeliveryAttemptRequest)(nil),  // 23: deliveryplanner.RecordFailedDeliveryAttemptRequest
+	(*RecordFailedDeliveryAttemptResponse)(nil), // 24: deliveryplanner.RecordFailedDeliveryAttemptResponse
+	(*DeleteRouteRequest)(nil),                  // 25: deliveryplanner.DeleteRouteRequest
+	(*DeleteRouteResponse)(nil),                 // 26: deliveryplanner.DeleteRouteResponse
+	(*timestamppb.Timestamp)(nil),               // 27: google.protobuf.Timestamp
+	(DeliveryFailureReason)(0),                  // 28: deliveryplanner.DeliveryFailureReason
+	(*Location)(nil),                            // 29: deliveryplanner.Location
+	(*ProofOfDelivery)(nil),                     // 30: deliveryplanner.ProofOfDelivery
+	(*Package)(nil),                             // 31: deliveryplanner.Package
 }
 var file_proto_route_proto_depIdxs = []int32{
-	18, // 0: deliveryplanner.PackageRoute.delivery_timestamp:type_name -> google.protobuf.Timestamp
-	18, // 1: deliveryplanner.Route.date:type_name -> google.protobuf.Timestamp
-	0,  // 2: deliveryplanner.Route.packages:type_name -> deliveryplanner.PackageRoute
-	18, // 3: deliveryplanner.Route.created_at:type_name -> google.protobuf.Timestamp
-	18, // 4: deliveryplanner.Route.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 5: deliveryplanner.CreateRouteRequest.date:type_name -> google.protobuf.Timestamp
-	1,  // 6: deliveryplanner.CreateRouteResponse.route:type_name -> deliveryplanner.Route
-	1,  // 7: deliveryplanner.GetRouteResponse.route:type_name -> deliveryplanner.Route
-	1,  // 8: deliveryplanner.ListRoutesResponse.routes:type_name -> deliveryplanner.Route
-	18, // 9: deliveryplanner.UpdateRouteRequest.date:type_name -> google.protobuf.Timestamp
-	1,  // 10: deliveryplanner.UpdateRouteResponse.route:type_name -> deliveryplanner.Route
-	1,  // 11: deliveryplanner.MarkRouteAsCompletedResponse.route:type_name -> deliveryplanner.Route
-	1,  // 12: deliveryplanner.AddPackagesToRouteResponse.route:type_name -> deliveryplanner.Route
-	1,  // 13: deliveryplanner.UpdatePackageDeliveryStatusResponse.route:type_name -> deliveryplanner.Route
-	2,  // 14: deliveryplanner.RouteService.CreateRoute:input_type -> deliveryplanner.CreateRouteRequest
-	4,  // 15: deliveryplanner.RouteService.GetRoute:input_type -> deliveryplanner.GetRouteRequest
-	6,  // 16: deliveryplanner.RouteService.ListRoutes:input_type -> deliveryplanner.ListRoutesRequest
-	8,  // 17: deliveryplanner.RouteService.UpdateRoute:input_type -> deliveryplanner.UpdateRouteRequest
-	10, // 18: deliveryplanner.RouteService.MarkRouteAsCompleted:input_type -> deliveryplanner.MarkRouteAsCompletedRequest
-	12, // 19: deliveryplanner.RouteService.AddPackagesToRoute:input_type -> deliveryplanner.AddPackagesToRouteRequest
-	14, // 20: deliveryplanner.RouteService.UpdatePackageDeliveryStatus:input_type -> deliveryplanner.UpdatePackageDeliveryStatusRequest
-	16, // 21: deliveryplanner.RouteService.DeleteRoute:input_type -> deliveryplanner.DeleteRouteRequest
-	3,  // 22: deliveryplanner.RouteService.CreateRoute:output_type -> deliveryplanner.CreateRouteResponse
-	5,  // 23: deliveryplanner.RouteService.GetRoute:output_type -> deliveryplanner.GetRouteResponse
-	7,  // 24: deliveryplanner.RouteService.ListRoutes:output_type -> deliveryplanner.ListRoutesResponse
-	9,  // 25: deliveryplanner.RouteService.UpdateRoute:output_type -> deliveryplanner.UpdateRouteResponse
-	11, // 26: deliveryplanner.RouteService.MarkRouteAsCompleted:output_type -> deliveryplanner.MarkRouteAsCompletedResponse
-	13, // 27: deliveryplanner.RouteService.AddPackagesToRoute:output_type -> deliveryplanner.AddPackagesToRouteResponse
-	15, // 28: deliveryplanner.RouteService.UpdatePackageDeliveryStatus:output_type -> deliveryplanner.UpdatePackageDeliveryStatusResponse
-	17, // 29: deliveryplanner.RouteService.DeleteRoute:output_type -> deliveryplanner.DeleteRouteResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	27, // 0: deliveryplanner.PackageRoute.delivery_timestamp:type_name -> google.protobuf.Timestamp
+	27, // 1: deliveryplanner.PackageRoute.planned_arrival:type_name -> google.protobuf.Timestamp
+	27, // 2: deliveryplanner.PackageRoute.projected_arrival:type_name -> google.protobuf.Timestamp
+	28, // 3: deliveryplanner.PackageRoute.failure_reason:type_name -> deliveryplanner.DeliveryFailureReason
+	27, // 4: deliveryplanner.Route.date:type_name -> google.protobuf.Timestamp
+	1,  // 5: deliveryplanner.Route.packages:type_name -> deliveryplanner.PackageRoute
+	27, // 6: deliveryplanner.Route.created_at:type_name -> google.protobuf.Timestamp
+	27, // 7: deliveryplanner.Route.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 8: deliveryplanner.Route.start_location:type_name -> deliveryplanner.Location
+	0,  // 9: deliveryplanner.Route.status:type_name -> deliveryplanner.RouteStatus
+	27, // 10: deliveryplanner.CreateRouteRequest.date:type_name -> google.protobuf.Timestamp
+	29, // 11: deliveryplanner.CreateRouteRequest.start_location:type_name -> deliveryplanner.Location
+	2,  // 12: deliveryplanner.CreateRouteResponse.route:type_name -> deliveryplanner.Route
+	2,  // 13: deliveryplanner.GetRouteResponse.route:type_name -> deliveryplanner.Route
+	2,  // 14: deliveryplanner.GetRouteETAResponse.route:type_name -> deliveryplanner.Route
+	0,  // 15: deliveryplanner.ListRoutesRequest.status:type_name -> deliveryplanner.RouteStatus
+	27, // 16: deliveryplanner.ListRoutesRequest.date_from:type_name -> google.protobuf.Timestamp
+	27, // 17: deliveryplanner.ListRoutesRequest.date_to:type_name -> google.protobuf.Timestamp
+	2,  // 18: deliveryplanner.ListRoutesResponse.routes:type_name -> deliveryplanner.Route
+	27, // 19: deliveryplanner.UpdateRouteRequest.date:type_name -> google.protobuf.Timestamp
+	2,  // 20: deliveryplanner.UpdateRouteResponse.route:type_name -> deliveryplanner.Route
+	2,  // 21: deliveryplanner.MarkRouteAsCompletedResponse.route:type_name -> deliveryplanner.Route
+	0,  // 22: deliveryplanner.UpdateRouteStatusRequest.status:type_name -> deliveryplanner.RouteStatus
+	2,  // 23: deliveryplanner.UpdateRouteStatusResponse.route:type_name -> deliveryplanner.Route
+	2,  // 24: deliveryplanner.AddPackagesToRouteResponse.route:type_name -> deliveryplanner.Route
+	2,  // 25: deliveryplanner.OptimizeRouteResponse.route:type_name -> deliveryplanner.Route
+	30, // 26: deliveryplanner.UpdatePackageDeliveryStatusRequest.proof:type_name -> deliveryplanner.ProofOfDelivery
+	2,  // 27: deliveryplanner.UpdatePackageDeliveryStatusResponse.route:type_name -> deliveryplanner.Route
+	28, // 28: deliveryplanner.RecordFailedDeliveryAttemptRequest.reason:type_name -> deliveryplanner.DeliveryFailureReason
+	31, // 29: deliveryplanner.RecordFailedDeliveryAttemptResponse.package:type_name -> deliveryplanner.Package
+	3,  // 30: deliveryplanner.RouteService.CreateRoute:input_type -> deliveryplanner.CreateRouteRequest
+	5,  // 31: deliveryplanner.RouteService.GetRoute:input_type -> deliveryplanner.GetRouteRequest
+	7,  // 32: deliveryplanner.RouteService.GetRouteETA:input_type -> deliveryplanner.GetRouteETARequest
+	9,  // 33: deliveryplanner.RouteService.ListRoutes:input_type -> deliveryplanner.ListRoutesRequest
+	11, // 34: deliveryplanner.RouteService.UpdateRoute:input_type -> deliveryplanner.UpdateRouteRequest
+	13, // 35: deliveryplanner.RouteService.MarkRouteAsCompleted:input_type -> deliveryplanner.MarkRouteAsCompletedRequest
+	15, // 36: deliveryplanner.RouteService.UpdateRouteStatus:input_type -> deliveryplanner.UpdateRouteStatusRequest
+	17, // 37: deliveryplanner.RouteService.AddPackagesToRoute:input_type -> deliveryplanner.AddPackagesToRouteRequest
+	19, // 38: deliveryplanner.RouteService.OptimizeRoute:input_type -> deliveryplanner.OptimizeRouteRequest
+	21, // 39: deliveryplanner.RouteService.UpdatePackageDeliveryStatus:input_type -> deliveryplanner.UpdatePackageDeliveryStatusRequest
+	23, // 40: deliveryplanner.RouteService.RecordFailedDeliveryAttempt:input_type -> deliveryplanner.RecordFailedDeliveryAttemptRequest
+	25, // 41: deliveryplanner.RouteService.DeleteRoute:input_type -> deliveryplanner.DeleteRouteRequest
+	4,  // 42: deliveryplanner.RouteService.CreateRoute:output_type -> deliveryplanner.CreateRouteResponse
+	6,  // 43: deliveryplanner.RouteService.GetRoute:output_type -> deliveryplanner.GetRouteResponse
+	8,  // 44: deliveryplanner.RouteService.GetRouteETA:output_type -> deliveryplanner.GetRouteETAResponse
+	10, // 45: deliveryplanner.RouteService.ListRoutes:output_type -> deliveryplanner.ListRoutesResponse
+	12, // 46: deliveryplanner.RouteService.UpdateRoute:output_type -> deliveryplanner.UpdateRouteResponse
+	14, // 47: deliveryplanner.RouteService.MarkRouteAsCompleted:output_type -> deliveryplanner.MarkRouteAsCompletedResponse
+	16, // 48: deliveryplanner.RouteService.UpdateRouteStatus:output_type -> deliveryplanner.UpdateRouteStatusResponse
+	18, // 49: deliveryplanner.RouteService.AddPackagesToRoute:output_type -> deliveryplanner.AddPackagesToRouteResponse
+	20, // 50: deliveryplanner.RouteService.OptimizeRoute:output_type -> deliveryplanner.OptimizeRouteResponse
+	22, // 51: deliveryplanner.RouteService.UpdatePackageDeliveryStatus:output_type -> deliveryplanner.UpdatePackageDeliveryStatusResponse
+	24, // 52: deliveryplanner.RouteService.RecordFailedDeliveryAttempt:output_type -> deliveryplanner.RecordFailedDeliveryAttemptResponse
+	26, // 53: deliveryplanner.RouteService.DeleteRoute:output_type -> deliveryplanner.DeleteRouteResponse
+	42, // [42:54] is the sub-list for method output_type
+	30, // [30:42] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_proto_route_proto_init() }
@@ -1274,6 +2168,7 @@ func file_proto_route_proto_init() {
 	if File_proto_route_proto != nil {
 		return
 	}
+	file_proto_package_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proto_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageRoute); i {
@@ -1348,7 +2243,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoutesRequest); i {
+			switch v := v.(*GetRouteETARequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1360,7 +2255,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoutesResponse); i {
+			switch v := v.(*GetRouteETAResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,7 +2267,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRouteRequest); i {
+			switch v := v.(*ListRoutesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,7 +2279,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRouteResponse); i {
+			switch v := v.(*ListRoutesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,7 +2291,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkRouteAsCompletedRequest); i {
+			switch v := v.(*UpdateRouteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +2303,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkRouteAsCompletedResponse); i {
+			switch v := v.(*UpdateRouteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,7 +2315,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPackagesToRouteRequest); i {
+			switch v := v.(*MarkRouteAsCompletedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1432,7 +2327,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPackagesToRouteResponse); i {
+			switch v := v.(*MarkRouteAsCompletedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1444,7 +2339,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageDeliveryStatusRequest); i {
+			switch v := v.(*UpdateRouteStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,7 +2351,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePackageDeliveryStatusResponse); i {
+			switch v := v.(*UpdateRouteStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,7 +2363,7 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRouteRequest); i {
+			switch v := v.(*AddPackagesToRouteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,6 +2375,102 @@ func file_proto_route_proto_init() {
 			}
 		}
 		file_proto_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPackagesToRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePackageDeliveryStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePackageDeliveryStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordFailedDeliveryAttemptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordFailedDeliveryAttemptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteRouteResponse); i {
 			case 0:
 				return &v.state
@@ -1492,18 +2483,20 @@ func file_proto_route_proto_init() {
 			}
 		}
 	}
+	file_proto_route_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_route_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      1,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_route_proto_goTypes,
 		DependencyIndexes: file_proto_route_proto_depIdxs,
+		EnumInfos:         file_proto_route_proto_enumTypes,
 		MessageInfos:      file_proto_route_proto_msgTypes,
 	}.Build()
 	File_proto_route_proto = out.File
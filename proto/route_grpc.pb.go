@@ -20,11 +20,15 @@ const _ = grpc.SupportPackageIsVersion7
 type RouteServiceClient interface {
 	CreateRoute(ctx context.Context, in *CreateRouteRequest, opts ...grpc.CallOption) (*CreateRouteResponse, error)
 	GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error)
+	GetRouteETA(ctx context.Context, in *GetRouteETARequest, opts ...grpc.CallOption) (*GetRouteETAResponse, error)
 	ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc.CallOption) (*ListRoutesResponse, error)
 	UpdateRoute(ctx context.Context, in *UpdateRouteRequest, opts ...grpc.CallOption) (*UpdateRouteResponse, error)
 	MarkRouteAsCompleted(ctx context.Context, in *MarkRouteAsCompletedRequest, opts ...grpc.CallOption) (*MarkRouteAsCompletedResponse, error)
+	UpdateRouteStatus(ctx context.Context, in *UpdateRouteStatusRequest, opts ...grpc.CallOption) (*UpdateRouteStatusResponse, error)
 	AddPackagesToRoute(ctx context.Context, in *AddPackagesToRouteRequest, opts ...grpc.CallOption) (*AddPackagesToRouteResponse, error)
+	OptimizeRoute(ctx context.Context, in *OptimizeRouteRequest, opts ...grpc.CallOption) (*OptimizeRouteResponse, error)
 	UpdatePackageDeliveryStatus(ctx context.Context, in *UpdatePackageDeliveryStatusRequest, opts ...grpc.CallOption) (*UpdatePackageDeliveryStatusResponse, error)
+	RecordFailedDeliveryAttempt(ctx context.Context, in *RecordFailedDeliveryAttemptRequest, opts ...grpc.CallOption) (*RecordFailedDeliveryAttemptResponse, error)
 	DeleteRoute(ctx context.Context, in *DeleteRouteRequest, opts ...grpc.CallOption) (*DeleteRouteResponse, error)
 }
 
@@ -54,6 +58,15 @@ func (c *routeServiceClient) GetRoute(ctx context.Context, in *GetRouteRequest,
 	return out, nil
 }
 
+func (c *routeServiceClient) GetRouteETA(ctx context.Context, in *GetRouteETARequest, opts ...grpc.CallOption) (*GetRouteETAResponse, error) {
+	out := new(GetRouteETAResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/GetRouteETA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeServiceClient) ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc.CallOption) (*ListRoutesResponse, error) {
 	out := new(ListRoutesResponse)
 	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/ListRoutes", in, out, opts...)
@@ -81,6 +94,15 @@ func (c *routeServiceClient) MarkRouteAsCompleted(ctx context.Context, in *MarkR
 	return out, nil
 }
 
+func (c *routeServiceClient) UpdateRouteStatus(ctx context.Context, in *UpdateRouteStatusRequest, opts ...grpc.CallOption) (*UpdateRouteStatusResponse, error) {
+	out := new(UpdateRouteStatusResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/UpdateRouteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeServiceClient) AddPackagesToRoute(ctx context.Context, in *AddPackagesToRouteRequest, opts ...grpc.CallOption) (*AddPackagesToRouteResponse, error) {
 	out := new(AddPackagesToRouteResponse)
 	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/AddPackagesToRoute", in, out, opts...)
@@ -90,6 +112,15 @@ func (c *routeServiceClient) AddPackagesToRoute(ctx context.Context, in *AddPack
 	return out, nil
 }
 
+func (c *routeServiceClient) OptimizeRoute(ctx context.Context, in *OptimizeRouteRequest, opts ...grpc.CallOption) (*OptimizeRouteResponse, error) {
+	out := new(OptimizeRouteResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/OptimizeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeServiceClient) UpdatePackageDeliveryStatus(ctx context.Context, in *UpdatePackageDeliveryStatusRequest, opts ...grpc.CallOption) (*UpdatePackageDeliveryStatusResponse, error) {
 	out := new(UpdatePackageDeliveryStatusResponse)
 	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/UpdatePackageDeliveryStatus", in, out, opts...)
@@ -99,6 +130,15 @@ func (c *routeServiceClient) UpdatePackageDeliveryStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *routeServiceClient) RecordFailedDeliveryAttempt(ctx context.Context, in *RecordFailedDeliveryAttemptRequest, opts ...grpc.CallOption) (*RecordFailedDeliveryAttemptResponse, error) {
+	out := new(RecordFailedDeliveryAttemptResponse)
+	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/RecordFailedDeliveryAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeServiceClient) DeleteRoute(ctx context.Context, in *DeleteRouteRequest, opts ...grpc.CallOption) (*DeleteRouteResponse, error) {
 	out := new(DeleteRouteResponse)
 	err := c.cc.Invoke(ctx, "/deliveryplanner.RouteService/DeleteRoute", in, out, opts...)
@@ -114,11 +154,15 @@ func (c *routeServiceClient) DeleteRoute(ctx context.Context, in *DeleteRouteReq
 type RouteServiceServer interface {
 	CreateRoute(context.Context, *CreateRouteRequest) (*CreateRouteResponse, error)
 	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
+	GetRouteETA(context.Context, *GetRouteETARequest) (*GetRouteETAResponse, error)
 	ListRoutes(context.Context, *ListRoutesRequest) (*ListRoutesResponse, error)
 	UpdateRoute(context.Context, *UpdateRouteRequest) (*UpdateRouteResponse, error)
 	MarkRouteAsCompleted(context.Context, *MarkRouteAsCompletedRequest) (*MarkRouteAsCompletedResponse, error)
+	UpdateRouteStatus(context.Context, *UpdateRouteStatusRequest) (*UpdateRouteStatusResponse, error)
 	AddPackagesToRoute(context.Context, *AddPackagesToRouteRequest) (*AddPackagesToRouteResponse, error)
+	OptimizeRoute(context.Context, *OptimizeRouteRequest) (*OptimizeRouteResponse, error)
 	UpdatePackageDeliveryStatus(context.Context, *UpdatePackageDeliveryStatusRequest) (*UpdatePackageDeliveryStatusResponse, error)
+	RecordFailedDeliveryAttempt(context.Context, *RecordFailedDeliveryAttemptRequest) (*RecordFailedDeliveryAttemptResponse, error)
 	DeleteRoute(context.Context, *DeleteRouteRequest) (*DeleteRouteResponse, error)
 	mustEmbedUnimplementedRouteServiceServer()
 }
@@ -133,6 +177,9 @@ func (UnimplementedRouteServiceServer) CreateRoute(context.Context, *CreateRoute
 func (UnimplementedRouteServiceServer) GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoute not implemented")
 }
+func (UnimplementedRouteServiceServer) GetRouteETA(context.Context, *GetRouteETARequest) (*GetRouteETAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteETA not implemented")
+}
 func (UnimplementedRouteServiceServer) ListRoutes(context.Context, *ListRoutesRequest) (*ListRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoutes not implemented")
 }
@@ -142,12 +189,21 @@ func (UnimplementedRouteServiceServer) UpdateRoute(context.Context, *UpdateRoute
 func (UnimplementedRouteServiceServer) MarkRouteAsCompleted(context.Context, *MarkRouteAsCompletedRequest) (*MarkRouteAsCompletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkRouteAsCompleted not implemented")
 }
+func (UnimplementedRouteServiceServer) UpdateRouteStatus(context.Context, *UpdateRouteStatusRequest) (*UpdateRouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRouteStatus not implemented")
+}
 func (UnimplementedRouteServiceServer) AddPackagesToRoute(context.Context, *AddPackagesToRouteRequest) (*AddPackagesToRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPackagesToRoute not implemented")
 }
+func (UnimplementedRouteServiceServer) OptimizeRoute(context.Context, *OptimizeRouteRequest) (*OptimizeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeRoute not implemented")
+}
 func (UnimplementedRouteServiceServer) UpdatePackageDeliveryStatus(context.Context, *UpdatePackageDeliveryStatusRequest) (*UpdatePackageDeliveryStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePackageDeliveryStatus not implemented")
 }
+func (UnimplementedRouteServiceServer) RecordFailedDeliveryAttempt(context.Context, *RecordFailedDeliveryAttemptRequest) (*RecordFailedDeliveryAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordFailedDeliveryAttempt not implemented")
+}
 func (UnimplementedRouteServiceServer) DeleteRoute(context.Context, *DeleteRouteRequest) (*DeleteRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoute not implemented")
 }
@@ -200,6 +256,24 @@ func _RouteService_GetRoute_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RouteService_GetRouteETA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteETARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServiceServer).GetRouteETA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.RouteService/GetRouteETA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServiceServer).GetRouteETA(ctx, req.(*GetRouteETARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RouteService_ListRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRoutesRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +328,24 @@ func _RouteService_MarkRouteAsCompleted_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RouteService_UpdateRouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServiceServer).UpdateRouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.RouteService/UpdateRouteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServiceServer).UpdateRouteStatus(ctx, req.(*UpdateRouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RouteService_AddPackagesToRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPackagesToRouteRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +364,24 @@ func _RouteService_AddPackagesToRoute_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RouteService_OptimizeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServiceServer).OptimizeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.RouteService/OptimizeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServiceServer).OptimizeRoute(ctx, req.(*OptimizeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RouteService_UpdatePackageDeliveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePackageDeliveryStatusRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +400,24 @@ func _RouteService_UpdatePackageDeliveryStatus_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RouteService_RecordFailedDeliveryAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordFailedDeliveryAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServiceServer).RecordFailedDeliveryAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deliveryplanner.RouteService/RecordFailedDeliveryAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServiceServer).RecordFailedDeliveryAttempt(ctx, req.(*RecordFailedDeliveryAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RouteService_DeleteRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRouteRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +451,10 @@ var RouteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoute",
 			Handler:    _RouteService_GetRoute_Handler,
 		},
+		{
+			MethodName: "GetRouteETA",
+			Handler:    _RouteService_GetRouteETA_Handler,
+		},
 		{
 			MethodName: "ListRoutes",
 			Handler:    _RouteService_ListRoutes_Handler,
@@ -335,14 +467,26 @@ var RouteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkRouteAsCompleted",
 			Handler:    _RouteService_MarkRouteAsCompleted_Handler,
 		},
+		{
+			MethodName: "UpdateRouteStatus",
+			Handler:    _RouteService_UpdateRouteStatus_Handler,
+		},
 		{
 			MethodName: "AddPackagesToRoute",
 			Handler:    _RouteService_AddPackagesToRoute_Handler,
 		},
+		{
+			MethodName: "OptimizeRoute",
+			Handler:    _RouteService_OptimizeRoute_Handler,
+		},
 		{
 			MethodName: "UpdatePackageDeliveryStatus",
 			Handler:    _RouteService_UpdatePackageDeliveryStatus_Handler,
 		},
+		{
+			MethodName: "RecordFailedDeliveryAttempt",
+			Handler:    _RouteService_RecordFailedDeliveryAttempt_Handler,
+		},
 		{
 			MethodName: "DeleteRoute",
 			Handler:    _RouteService_DeleteRoute_Handler,